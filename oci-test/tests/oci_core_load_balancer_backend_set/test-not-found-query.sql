@@ -0,0 +1,3 @@
+select name, load_balancer_id, policy
+from oci.oci_core_load_balancer_backend_set
+where load_balancer_id = '{{ output.load_balancer_id.value }}' and name = 'dummy-{{ resourceName }}';
@@ -0,0 +1,3 @@
+select title, tenant_id
+from oci.oci_core_load_balancer_listener
+where load_balancer_id = '{{ output.load_balancer_id.value }}' and name = '{{ resourceName }}';
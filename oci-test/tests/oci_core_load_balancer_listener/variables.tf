@@ -0,0 +1,81 @@
+variable "resource_name" {
+  type        = string
+  default     = "steampipetest20200125"
+  description = "Name of the resource used throughout the test."
+}
+
+variable "tenancy_ocid" {
+  type        = string
+  default     = ""
+  description = "OCI credentials profile used for the test. Default is to use the default profile."
+}
+
+variable "config_file_profile" {
+  type        = string
+  default     = "DEFAULT"
+  description = "OCI credentials profile used for the test. Default is to use the default profile."
+}
+
+variable "region" {
+  type        = string
+  default     = "ap-mumbai-1"
+  description = "OCI region used for the test. Does not work with default region in config, so must be defined here."
+}
+
+provider "oci" {
+  tenancy_ocid        = var.tenancy_ocid
+  config_file_profile = var.config_file_profile
+  region              = var.region
+}
+
+resource "oci_core_vcn" "named_test_resource" {
+  compartment_id = var.tenancy_ocid
+  display_name   = var.resource_name
+  cidr_block     = "10.0.0.0/16"
+}
+
+resource "oci_core_subnet" "named_test_resource" {
+  compartment_id = var.tenancy_ocid
+  display_name   = var.resource_name
+  cidr_block     = "10.0.0.0/16"
+  vcn_id         = oci_core_vcn.named_test_resource.id
+}
+
+resource "oci_load_balancer_load_balancer" "named_test_resource" {
+  compartment_id = var.tenancy_ocid
+  display_name   = var.resource_name
+  shape          = "100Mbps"
+  subnet_ids     = [oci_core_subnet.named_test_resource.id]
+}
+
+resource "oci_load_balancer_backend_set" "named_test_resource" {
+  load_balancer_id = oci_load_balancer_load_balancer.named_test_resource.id
+  name             = var.resource_name
+  policy           = "ROUND_ROBIN"
+
+  health_checker {
+    protocol = "HTTP"
+    port     = 80
+    url_path = "/"
+  }
+}
+
+resource "oci_load_balancer_listener" "named_test_resource" {
+  load_balancer_id         = oci_load_balancer_load_balancer.named_test_resource.id
+  name                     = var.resource_name
+  default_backend_set_name = oci_load_balancer_backend_set.named_test_resource.name
+  port                     = 80
+  protocol                 = "HTTP"
+}
+
+output "resource_name" {
+  value = var.resource_name
+}
+
+output "tenancy_ocid" {
+  value = var.tenancy_ocid
+}
+
+output "load_balancer_id" {
+  value = oci_load_balancer_load_balancer.named_test_resource.id
+}
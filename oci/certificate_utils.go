@@ -0,0 +1,252 @@
+package oci
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// certificateDetails holds the parsed attributes of an X.509 certificate
+type certificateDetails struct {
+	Subject            string    `json:"subject"`
+	SubjectCommonName  string    `json:"subject_common_name"`
+	Issuer             string    `json:"issuer"`
+	IssuerCommonName   string    `json:"issuer_common_name"`
+	SerialNumber       string    `json:"serial_number"`
+	NotBefore          time.Time `json:"not_before"`
+	NotAfter           time.Time `json:"not_after"`
+	DNSNames           []string  `json:"dns_names,omitempty"`
+	IPAddresses        []string  `json:"ip_addresses,omitempty"`
+	EmailAddresses     []string  `json:"email_addresses,omitempty"`
+	KeyAlgorithm       string    `json:"key_algorithm"`
+	KeySize            int       `json:"key_size"`
+	SignatureAlgorithm string    `json:"signature_algorithm"`
+	IsCA               bool      `json:"is_ca"`
+	IsSelfSigned       bool      `json:"is_self_signed"`
+	Fingerprint        string    `json:"fingerprint_sha256"`
+}
+
+// append the parsed X.509 certificate columns onto the column list. The hydrate
+// item must carry the parse result in CertificateDetails and CertificateParseError fields.
+func CertificateColumns(columns []*plugin.Column) []*plugin.Column {
+	return append(columns, commonCertificateColumns()...)
+}
+
+// append the parsed X.509 certificate columns onto the column list, populated
+// by the given hydrate function rather than the list item. Used where the PEM has
+// to be fetched with a separate API call.
+func HydratedCertificateColumns(columns []*plugin.Column, hydrate plugin.HydrateFunc) []*plugin.Column {
+	for _, column := range commonCertificateColumns() {
+		column.Hydrate = hydrate
+		columns = append(columns, column)
+	}
+	return columns
+}
+
+func commonCertificateColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "subject",
+			Description: "The distinguished name of the certificate subject.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("CertificateDetails.Subject"),
+		},
+		{
+			Name:        "subject_common_name",
+			Description: "The common name of the certificate subject.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("CertificateDetails.SubjectCommonName"),
+		},
+		{
+			Name:        "issuer",
+			Description: "The distinguished name of the certificate issuer.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("CertificateDetails.Issuer"),
+		},
+		{
+			Name:        "issuer_common_name",
+			Description: "The common name of the certificate issuer.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("CertificateDetails.IssuerCommonName"),
+		},
+		{
+			Name:        "serial_number",
+			Description: "The serial number of the certificate.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("CertificateDetails.SerialNumber"),
+		},
+		{
+			Name:        "not_before",
+			Description: "The time before which the certificate is not valid.",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Transform:   transform.FromField("CertificateDetails.NotBefore"),
+		},
+		{
+			Name:        "not_after",
+			Description: "The time after which the certificate is not valid.",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Transform:   transform.FromField("CertificateDetails.NotAfter"),
+		},
+		{
+			Name:        "subject_alternative_names",
+			Description: "The DNS names, IP addresses and email addresses listed as subject alternative names.",
+			Type:        proto.ColumnType_JSON,
+			Transform:   transform.FromField("CertificateDetails").Transform(certificateSubjectAlternativeNames),
+		},
+		{
+			Name:        "key_algorithm",
+			Description: "The public key algorithm of the certificate.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("CertificateDetails.KeyAlgorithm"),
+		},
+		{
+			Name:        "key_size",
+			Description: "The size, in bits, of the certificate public key.",
+			Type:        proto.ColumnType_INT,
+			Transform:   transform.FromField("CertificateDetails.KeySize"),
+		},
+		{
+			Name:        "signature_algorithm",
+			Description: "The algorithm used to sign the certificate.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("CertificateDetails.SignatureAlgorithm"),
+		},
+		{
+			Name:        "is_self_signed",
+			Description: "Whether the certificate is signed by its own key.",
+			Type:        proto.ColumnType_BOOL,
+			Transform:   transform.FromField("CertificateDetails.IsSelfSigned"),
+		},
+		{
+			Name:        "fingerprint_sha256",
+			Description: "The hex encoded SHA-256 fingerprint of the certificate.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("CertificateDetails.Fingerprint"),
+		},
+		{
+			Name:        "certificate_parse_error",
+			Description: "The error raised while parsing the PEM encoded certificate, if any.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("CertificateParseError"),
+		},
+	}
+}
+
+// parsePEMCertificate parses the first certificate found in a PEM encoded string.
+// Any leading non-certificate blocks, e.g. private keys, are skipped.
+func parsePEMCertificate(data string) (*certificateDetails, error) {
+	rest := []byte(strings.TrimSpace(data))
+	for len(rest) > 0 {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return buildCertificateDetails(cert), nil
+	}
+
+	return nil, errors.New("no PEM encoded certificate found")
+}
+
+// parsePEMCertificates parses every certificate found in a PEM encoded string,
+// e.g. a CA bundle or certificate chain, skipping any other block types
+func parsePEMCertificates(data string) ([]*certificateDetails, error) {
+	var certificates []*certificateDetails
+
+	rest := []byte(strings.TrimSpace(data))
+	for len(rest) > 0 {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return certificates, err
+		}
+		certificates = append(certificates, buildCertificateDetails(cert))
+	}
+
+	if len(certificates) == 0 {
+		return nil, errors.New("no PEM encoded certificate found")
+	}
+
+	return certificates, nil
+}
+
+func buildCertificateDetails(cert *x509.Certificate) *certificateDetails {
+	fingerprint := sha256.Sum256(cert.Raw)
+
+	details := &certificateDetails{
+		Subject:            cert.Subject.String(),
+		SubjectCommonName:  cert.Subject.CommonName,
+		Issuer:             cert.Issuer.String(),
+		IssuerCommonName:   cert.Issuer.CommonName,
+		SerialNumber:       cert.SerialNumber.String(),
+		NotBefore:          cert.NotBefore,
+		NotAfter:           cert.NotAfter,
+		DNSNames:           cert.DNSNames,
+		EmailAddresses:     cert.EmailAddresses,
+		KeyAlgorithm:       cert.PublicKeyAlgorithm.String(),
+		SignatureAlgorithm: cert.SignatureAlgorithm.String(),
+		IsCA:               cert.IsCA,
+		IsSelfSigned:       bytes.Equal(cert.RawSubject, cert.RawIssuer) && cert.CheckSignature(cert.SignatureAlgorithm, cert.RawTBSCertificate, cert.Signature) == nil,
+		Fingerprint:        hex.EncodeToString(fingerprint[:]),
+	}
+
+	for _, ip := range cert.IPAddresses {
+		details.IPAddresses = append(details.IPAddresses, ip.String())
+	}
+
+	switch key := cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		details.KeySize = key.N.BitLen()
+	case *ecdsa.PublicKey:
+		details.KeySize = key.Curve.Params().BitSize
+	case ed25519.PublicKey:
+		details.KeySize = len(key) * 8
+	}
+
+	return details
+}
+
+//// TRANSFORM FUNCTION
+
+func certificateSubjectAlternativeNames(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	details, ok := d.Value.(*certificateDetails)
+	if !ok || details == nil {
+		return nil, nil
+	}
+
+	names := []string{}
+	names = append(names, details.DNSNames...)
+	names = append(names, details.IPAddresses...)
+	names = append(names, details.EmailAddresses...)
+
+	return names, nil
+}
@@ -0,0 +1,171 @@
+package oci
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/turbot/go-kit/helpers"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// compartmentPathColumns appends the compartment_path column onto the column
+// list. The hydrate item must carry the OCID of its compartment in a
+// CompartmentId field.
+func compartmentPathColumns(columns []*plugin.Column) []*plugin.Column {
+	return append(columns, &plugin.Column{
+		Name:        "compartment_path",
+		Description: "The path of the compartment in which the resource is located, from the root compartment, e.g. root/Prod/Network.",
+		Type:        proto.ColumnType_STRING,
+		Hydrate:     getCompartmentPath,
+		Transform:   transform.FromValue(),
+	})
+}
+
+// compartmentNode is a compartment in the tenancy's compartment hierarchy
+type compartmentNode struct {
+	Id       string
+	Name     string
+	ParentId string
+	Children []string
+}
+
+// compartmentTree indexes the active compartments of the tenancy by OCID.
+// The root compartment is the tenancy itself.
+type compartmentTree struct {
+	RootId string
+	Nodes  map[string]*compartmentNode
+}
+
+// getCompartmentTree builds the compartment hierarchy from listAllCompartments
+// and caches it for the connection
+func getCompartmentTree(ctx context.Context, d *plugin.QueryData) (*compartmentTree, error) {
+	cacheKey := "compartmentTree"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(*compartmentTree), nil
+	}
+
+	compartments, err := listAllCompartments(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// listAllCompartments returns the root compartment first
+	tree := &compartmentTree{
+		RootId: types.SafeString(compartments[0].Id),
+		Nodes:  map[string]*compartmentNode{},
+	}
+	for _, compartment := range compartments {
+		id := types.SafeString(compartment.Id)
+		tree.Nodes[id] = &compartmentNode{
+			Id:       id,
+			Name:     types.SafeString(compartment.Name),
+			ParentId: types.SafeString(compartment.CompartmentId),
+		}
+	}
+	for _, node := range tree.Nodes {
+		if parent, ok := tree.Nodes[node.ParentId]; ok && node.Id != tree.RootId {
+			parent.Children = append(parent.Children, node.Id)
+		}
+	}
+	for _, node := range tree.Nodes {
+		sort.Strings(node.Children)
+	}
+
+	d.ConnectionManager.Cache.Set(cacheKey, tree)
+
+	return tree, nil
+}
+
+// subtree returns the compartment and all of its descendants, parents first
+func (t *compartmentTree) subtree(id string) []string {
+	if _, ok := t.Nodes[id]; !ok {
+		return nil
+	}
+
+	ids := []string{id}
+	for i := 0; i < len(ids); i++ {
+		ids = append(ids, t.Nodes[ids[i]].Children...)
+	}
+	return ids
+}
+
+// resolvePath resolves a colon separated compartment path, e.g. Prod:Network,
+// relative to the given compartment
+func (t *compartmentTree) resolvePath(fromId string, path string) (string, bool) {
+	current, ok := t.Nodes[fromId]
+	if !ok {
+		return "", false
+	}
+
+	for _, name := range strings.Split(path, ":") {
+		var next *compartmentNode
+		for _, childId := range current.Children {
+			if child := t.Nodes[childId]; strings.EqualFold(child.Name, name) {
+				next = child
+				break
+			}
+		}
+		if next == nil {
+			return "", false
+		}
+		current = next
+	}
+
+	return current.Id, true
+}
+
+// ancestors returns the OCIDs of the compartment's ancestors, root first
+func (t *compartmentTree) ancestors(id string) []string {
+	var ids []string
+	node, ok := t.Nodes[id]
+	for ok && node.Id != t.RootId {
+		node, ok = t.Nodes[node.ParentId]
+		if ok {
+			ids = append([]string{node.Id}, ids...)
+		}
+	}
+	return ids
+}
+
+// path returns the names of the compartment and its ancestors joined with
+// "/", starting from the root compartment, e.g. root/Prod/Network
+func (t *compartmentTree) path(id string) (string, bool) {
+	node, ok := t.Nodes[id]
+	if !ok {
+		return "", false
+	}
+
+	names := []string{"root"}
+	for _, ancestorId := range t.ancestors(id) {
+		if ancestorId != t.RootId {
+			names = append(names, t.Nodes[ancestorId].Name)
+		}
+	}
+	if node.Id != t.RootId {
+		names = append(names, node.Name)
+	}
+
+	return strings.Join(names, "/"), true
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCompartmentPath(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	compartmentId, _ := helpers.GetFieldValueFromInterface(h.Item, "CompartmentId")
+
+	tree, err := getCompartmentTree(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("getCompartmentPath", "api_error", err)
+		return nil, err
+	}
+
+	path, ok := tree.path(types.SafeString(compartmentId))
+	if !ok {
+		return nil, nil
+	}
+	return path, nil
+}
@@ -0,0 +1,110 @@
+package oci
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// configSecretFinding flags a configuration entry that looks like it holds a
+// plaintext secret. The value itself is never recorded.
+type configSecretFinding struct {
+	Key  string `json:"key"`
+	Rule string `json:"rule"`
+}
+
+type configSecretValueRule struct {
+	name    string
+	pattern *regexp.Regexp
+}
+
+// rules matched against configuration values, checked in order
+var configSecretValueRules = []configSecretValueRule{
+	{"private_key", regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----`)},
+	{"aws_access_key", regexp.MustCompile(`\b(AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{"github_token", regexp.MustCompile(`\bgh[pousr]_[A-Za-z0-9]{36,}\b`)},
+	{"slack_token", regexp.MustCompile(`\bxox[abprs]-[A-Za-z0-9-]{10,}`)},
+	{"jwt", regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{8,}\.eyJ[A-Za-z0-9_-]{8,}\.[A-Za-z0-9_-]{8,}`)},
+	{"url_credentials", regexp.MustCompile(`[a-zA-Z][a-zA-Z0-9+.-]*://[^/\s:@]+:[^/\s@]+@`)},
+}
+
+// fragments of configuration key names that suggest a secret value
+var configSecretKeyFragments = []string{
+	"password",
+	"passwd",
+	"secret",
+	"token",
+	"api_key",
+	"apikey",
+	"private_key",
+	"credential",
+}
+
+// findConfigSecrets returns the keys of config whose values look like
+// plaintext secrets, sorted by key. Values that are OCIDs are references to
+// vault secrets or other resources and are never flagged.
+func findConfigSecrets(config map[string]string) []configSecretFinding {
+	findings := []configSecretFinding{}
+
+	for key, value := range config {
+		if rule := matchConfigSecret(key, value); rule != "" {
+			findings = append(findings, configSecretFinding{Key: key, Rule: rule})
+		}
+	}
+
+	sort.Slice(findings, func(i, j int) bool {
+		return findings[i].Key < findings[j].Key
+	})
+
+	return findings
+}
+
+// matchConfigSecret returns the name of the first rule matching the entry, or
+// an empty string if the entry doesn't look like a secret
+func matchConfigSecret(key string, value string) string {
+	value = strings.TrimSpace(value)
+	if value == "" || strings.HasPrefix(value, "ocid1.") {
+		return ""
+	}
+
+	for _, rule := range configSecretValueRules {
+		if rule.pattern.MatchString(value) {
+			return rule.name
+		}
+	}
+
+	normalized := strings.ToLower(strings.NewReplacer("-", "_", ".", "_").Replace(key))
+	for _, fragment := range configSecretKeyFragments {
+		if strings.Contains(normalized, fragment) {
+			return "secret_key_name"
+		}
+	}
+
+	return ""
+}
+
+// configKeys returns the sorted key names of config
+func configKeys(config map[string]string) []string {
+	keys := make([]string, 0, len(config))
+	for key := range config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// configVisibilityInfo is a configuration map as exposed by the functions
+// tables, along with its key names and the keys that look like secrets
+type configVisibilityInfo struct {
+	Config               map[string]string
+	ConfigKeys           []string
+	ConfigSecretFindings []configSecretFinding
+}
+
+func buildConfigVisibilityInfo(config map[string]string) configVisibilityInfo {
+	return configVisibilityInfo{
+		Config:               config,
+		ConfigKeys:           configKeys(config),
+		ConfigSecretFindings: findConfigSecrets(config),
+	}
+}
@@ -6,18 +6,23 @@ import (
 )
 
 type ociConfig struct {
-	Auth                  *string  `cty:"auth"`
-	ConfigPath            *string  `cty:"config_path"`
-	Fingerprint           *string  `cty:"fingerprint"`
-	PrivateKey            *string  `cty:"private_key"`
-	PrivateKeyPassword    *string  `cty:"private_key_password"`
-	PrivateKeyPath        *string  `cty:"private_key_path"`
-	Profile               *string  `cty:"config_file_profile"`
-	Regions               []string `cty:"regions"`
-	TenancyOCID           *string  `cty:"tenancy_ocid"`
-	UserOCID              *string  `cty:"user_ocid"`
-	MaxErrorRetryAttempts *int     `cty:"max_error_retry_attempts"`
-	MinErrorRetryDelay    *int     `cty:"min_error_retry_delay"`
+	AllowQueueMessagePeek         *bool    `cty:"allow_queue_message_peek"`
+	AllowSecretContent            *bool    `cty:"allow_secret_content"`
+	Auth                          *string  `cty:"auth"`
+	ConfigPath                    *string  `cty:"config_path"`
+	Fingerprint                   *string  `cty:"fingerprint"`
+	KmsKeyRotationThresholdDays   *int     `cty:"kms_key_rotation_threshold_days"`
+	PrivateKey                    *string  `cty:"private_key"`
+	PrivateKeyPassword            *string  `cty:"private_key_password"`
+	PrivateKeyPath                *string  `cty:"private_key_path"`
+	Profile                       *string  `cty:"config_file_profile"`
+	Regions                       []string `cty:"regions"`
+	SecretContentHmacKey          *string  `cty:"secret_content_hmac_key"`
+	StreamPartitionLagMaxMessages *int     `cty:"stream_partition_lag_max_messages"`
+	TenancyOCID                   *string  `cty:"tenancy_ocid"`
+	UserOCID                      *string  `cty:"user_ocid"`
+	MaxErrorRetryAttempts         *int     `cty:"max_error_retry_attempts"`
+	MinErrorRetryDelay            *int     `cty:"min_error_retry_delay"`
 }
 
 var ConfigSchema = map[string]*schema.Attribute{
@@ -58,6 +63,21 @@ var ConfigSchema = map[string]*schema.Attribute{
 	"min_error_retry_delay": {
 		Type: schema.TypeInt,
 	},
+	"allow_queue_message_peek": {
+		Type: schema.TypeBool,
+	},
+	"allow_secret_content": {
+		Type: schema.TypeBool,
+	},
+	"kms_key_rotation_threshold_days": {
+		Type: schema.TypeInt,
+	},
+	"secret_content_hmac_key": {
+		Type: schema.TypeString,
+	},
+	"stream_partition_lag_max_messages": {
+		Type: schema.TypeInt,
+	},
 }
 
 func ConfigInstance() interface{} {
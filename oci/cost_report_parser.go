@@ -0,0 +1,205 @@
+package oci
+
+import (
+	"compress/gzip"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// costReportLineItem is a single line of an OCI cost report. Cost reports are
+// gzip compressed CSV files with a header row naming each column, e.g.
+// lineItem/intervalUsageStart or cost/myCost. Tag columns are named
+// tags/<namespace>.<key>.
+type costReportLineItem struct {
+	ReferenceNo           string
+	TenantId              string
+	IntervalUsageStart    *time.Time
+	IntervalUsageEnd      *time.Time
+	Service               string
+	Resource              string
+	CompartmentId         string
+	CompartmentName       string
+	Region                string
+	AvailabilityDomain    string
+	ResourceId            string
+	BilledQuantity        *float64
+	BilledQuantityOverage *float64
+	SubscriptionId        string
+	ProductSku            string
+	Description           string
+	UnitPrice             *float64
+	UnitPriceOverage      *float64
+	MyCost                *float64
+	MyCostOverage         *float64
+	CurrencyCode          string
+	BillingUnitReadable   string
+	SkuUnitDescription    string
+	OverageFlag           string
+	IsCorrection          bool
+	BackreferenceNo       string
+	Tags                  map[string]string
+	ReportName            string
+	ReportLineNumber      int
+	ParseError            *string
+}
+
+// costReportTimeLayouts are the timestamp formats used in cost reports
+var costReportTimeLayouts = []string{
+	"2006-01-02T15:04Z",
+	time.RFC3339,
+	"2006-01-02T15:04:05.000Z",
+}
+
+// parseCostReport decompresses and parses a gzip compressed cost report,
+// calling fn for each line item. Parsing stops early if fn returns false. A
+// line that cannot be parsed is passed to fn with the reason in ParseError,
+// and with the fields that could be parsed. An error is only returned if the
+// report itself cannot be read, e.g. because it is truncated.
+func parseCostReport(r io.Reader, fn func(costReportLineItem) bool) error {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+
+	reader := csv.NewReader(gz)
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// the record is reused, so copy the header names
+	columns := map[string]int{}
+	var tagColumns []string
+	for i, name := range header {
+		name = strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))
+		columns[name] = i
+		tagColumns = append(tagColumns, "")
+		if strings.HasPrefix(name, "tags/") {
+			tagColumns[i] = strings.TrimPrefix(name, "tags/")
+		}
+	}
+
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+
+		var item costReportLineItem
+		var csvErr *csv.ParseError
+		if errors.As(err, &csvErr) {
+			// the reader can carry on with the next line
+			item.ParseError = costReportParseError(err)
+		} else if err != nil {
+			return err
+		} else {
+			item, err = parseCostReportRecord(record, columns, tagColumns)
+			if err != nil {
+				item.ParseError = costReportParseError(err)
+			}
+		}
+
+		item.ReportLineNumber = line
+		if !fn(item) {
+			return nil
+		}
+	}
+}
+
+func parseCostReportRecord(record []string, columns map[string]int, tagColumns []string) (costReportLineItem, error) {
+	var err error
+	item := costReportLineItem{}
+
+	field := func(name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+	// fields that fail to parse are left empty, and the first error is returned
+	fail := func(fieldErr error) {
+		if err == nil {
+			err = fieldErr
+		}
+	}
+	timeField := func(name string) *time.Time {
+		value := field(name)
+		if value == "" {
+			return nil
+		}
+		for _, layout := range costReportTimeLayouts {
+			if t, parseErr := time.Parse(layout, value); parseErr == nil {
+				return &t
+			}
+		}
+		fail(fmt.Errorf("invalid timestamp %q in column %s", value, name))
+		return nil
+	}
+	floatField := func(name string) *float64 {
+		value := field(name)
+		if value == "" {
+			return nil
+		}
+		f, parseErr := strconv.ParseFloat(value, 64)
+		if parseErr != nil {
+			fail(fmt.Errorf("invalid number %q in column %s", value, name))
+			return nil
+		}
+		return &f
+	}
+
+	item.ReferenceNo = field("lineItem/referenceNo")
+	item.TenantId = field("lineItem/tenantId")
+	item.IntervalUsageStart = timeField("lineItem/intervalUsageStart")
+	item.IntervalUsageEnd = timeField("lineItem/intervalUsageEnd")
+	item.Service = field("product/service")
+	item.Resource = field("product/resource")
+	item.CompartmentId = field("product/compartmentId")
+	item.CompartmentName = field("product/compartmentName")
+	item.Region = field("product/region")
+	item.AvailabilityDomain = field("product/availabilityDomain")
+	item.ResourceId = field("product/resourceId")
+	item.BilledQuantity = floatField("usage/billedQuantity")
+	item.BilledQuantityOverage = floatField("usage/billedQuantityOverage")
+	item.SubscriptionId = field("cost/subscriptionId")
+	item.ProductSku = field("cost/productSku")
+	item.Description = field("product/Description")
+	item.UnitPrice = floatField("cost/unitPrice")
+	item.UnitPriceOverage = floatField("cost/unitPriceOverage")
+	item.MyCost = floatField("cost/myCost")
+	item.MyCostOverage = floatField("cost/myCostOverage")
+	item.CurrencyCode = field("cost/currencyCode")
+	item.BillingUnitReadable = field("cost/billingUnitReadable")
+	item.SkuUnitDescription = field("cost/skuUnitDescription")
+	item.OverageFlag = field("cost/overageFlag")
+	item.IsCorrection = strings.EqualFold(field("lineItem/isCorrection"), "true")
+	item.BackreferenceNo = field("lineItem/backreferenceNo")
+
+	for i, tag := range tagColumns {
+		if tag == "" || i >= len(record) || record[i] == "" {
+			continue
+		}
+		if item.Tags == nil {
+			item.Tags = map[string]string{}
+		}
+		item.Tags[tag] = record[i]
+	}
+
+	return item, err
+}
+
+func costReportParseError(err error) *string {
+	message := err.Error()
+	return &message
+}
@@ -0,0 +1,173 @@
+package oci
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func parseCostReportFixture(t *testing.T, name string) ([]costReportLineItem, error) {
+	t.Helper()
+
+	file, err := os.Open(filepath.Join("testdata", "cost_report", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	var items []costReportLineItem
+	err = parseCostReport(file, func(item costReportLineItem) bool {
+		items = append(items, item)
+		return true
+	})
+	return items, err
+}
+
+func TestParseCostReport(t *testing.T) {
+	items, err := parseCostReportFixture(t, "report.csv.gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 2 {
+		t.Fatalf("expected 2 line items, got %d", len(items))
+	}
+
+	item := items[0]
+	if item.ReferenceNo != "ref-1" || item.Service != "COMPUTE" || item.CompartmentName != "Prod" || item.AvailabilityDomain != "AD-1" {
+		t.Errorf("unexpected line item %+v", item)
+	}
+	if item.ReportLineNumber != 2 {
+		t.Errorf("expected report line number 2, got %d", item.ReportLineNumber)
+	}
+	if start := time.Date(2023, 1, 10, 0, 0, 0, 0, time.UTC); item.IntervalUsageStart == nil || !item.IntervalUsageStart.Equal(start) {
+		t.Errorf("expected interval usage start %v, got %v", start, item.IntervalUsageStart)
+	}
+	if item.MyCost == nil || *item.MyCost != 0.05 {
+		t.Errorf("expected cost 0.05, got %v", item.MyCost)
+	}
+	if item.MyCostOverage != nil {
+		t.Errorf("expected no overage cost, got %v", *item.MyCostOverage)
+	}
+	if item.Description != "Compute - Standard - E4 - OCPU" {
+		t.Errorf("unexpected description %q", item.Description)
+	}
+	if item.Tags["Oracle-Tags.CreatedBy"] != "alice" || item.Tags["Operations.CostCenter"] != "42" {
+		t.Errorf("unexpected tags %v", item.Tags)
+	}
+	if item.IsCorrection {
+		t.Error("expected line item not to be a correction")
+	}
+
+	correction := items[1]
+	if !correction.IsCorrection || correction.BackreferenceNo != "ref-0" {
+		t.Errorf("expected a correction of ref-0, got %+v", correction)
+	}
+	if correction.Tags != nil {
+		t.Errorf("expected no tags, got %v", correction.Tags)
+	}
+}
+
+func TestParseCostReportStopsEarly(t *testing.T) {
+	file, err := os.Open(filepath.Join("testdata", "cost_report", "report.csv.gz"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	count := 0
+	err = parseCostReport(file, func(costReportLineItem) bool {
+		count++
+		return false
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Errorf("expected parsing to stop after 1 line item, got %d", count)
+	}
+}
+
+func TestParseCostReportEmpty(t *testing.T) {
+	items, err := parseCostReportFixture(t, "empty.csv.gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 0 {
+		t.Errorf("expected no line items, got %d", len(items))
+	}
+}
+
+func TestParseCostReportInvalidNumber(t *testing.T) {
+	items, err := parseCostReportFixture(t, "invalid_number.csv.gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 1 {
+		t.Fatalf("expected 1 line item, got %d", len(items))
+	}
+
+	// the line is returned with the error and the fields that could be parsed
+	item := items[0]
+	if item.ParseError == nil || !strings.Contains(*item.ParseError, "cost/myCost") {
+		t.Errorf("expected an invalid number error, got %v", item.ParseError)
+	}
+	if item.ReportLineNumber != 2 || item.ReferenceNo != "ref-1" || item.MyCost != nil {
+		t.Errorf("unexpected line item %+v", item)
+	}
+	if item.UnitPrice == nil || *item.UnitPrice != 0.025 {
+		t.Errorf("expected unit price 0.025, got %v", item.UnitPrice)
+	}
+}
+
+func TestParseCostReportMalformedLine(t *testing.T) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	gz.Write([]byte("lineItem/referenceNo,cost/myCost\nref-1,1.5\nref-2,\"2\"x\nref-3,2.5,extra\nref-4,3.5\n"))
+	gz.Close()
+
+	var items []costReportLineItem
+	err := parseCostReport(&buf, func(item costReportLineItem) bool {
+		items = append(items, item)
+		return true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var summary []string
+	for _, item := range items {
+		summary = append(summary, fmt.Sprintf("%d:%s:%t", item.ReportLineNumber, item.ReferenceNo, item.ParseError != nil))
+	}
+	expected := "2:ref-1:false,3::true,4::true,5:ref-4:false"
+	if strings.Join(summary, ",") != expected {
+		t.Errorf("expected %s, got %s", expected, strings.Join(summary, ","))
+	}
+}
+
+func TestParseCostReportTruncated(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "cost_report", "report.csv.gz"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = parseCostReport(bytes.NewReader(data[:len(data)-10]), func(costReportLineItem) bool {
+		return true
+	})
+	if err == nil {
+		t.Error("expected an error for a truncated report")
+	}
+}
+
+func TestParseCostReportNotGzip(t *testing.T) {
+	err := parseCostReport(strings.NewReader("lineItem/referenceNo\nref-1\n"), func(costReportLineItem) bool {
+		return true
+	})
+	if err == nil {
+		t.Error("expected an error for a report that is not gzip compressed")
+	}
+}
@@ -0,0 +1,256 @@
+package oci
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// Maximum number of resources the Identity Domains SCIM API returns per page
+const identityDomainPageSize = 1000
+
+// validateIdentityDomainUrl checks that domainUrl is an identity domain
+// endpoint of the realm, e.g. https://idcs-abc123.identity.oraclecloud.com:443,
+// so that signed requests are never sent to another host
+func validateIdentityDomainUrl(domainUrl string, realmDomain string) error {
+	parsed, err := url.Parse(domainUrl)
+	if err != nil {
+		return fmt.Errorf("invalid domain_url %q: %v", domainUrl, err)
+	}
+	if parsed.Scheme != "https" || parsed.User != nil || strings.Trim(parsed.Path, "/") != "" || parsed.RawQuery != "" || parsed.Fragment != "" {
+		return fmt.Errorf("invalid domain_url %q: expected https://<domain>.identity.%s", domainUrl, realmDomain)
+	}
+	if port := parsed.Port(); port != "" && port != "443" {
+		return fmt.Errorf("invalid domain_url %q: expected port 443", domainUrl)
+	}
+	host := strings.ToLower(parsed.Hostname())
+	if !strings.HasSuffix(host, ".identity."+realmDomain) {
+		return fmt.Errorf("invalid domain_url %q: expected a host under identity.%s", domainUrl, realmDomain)
+	}
+	return nil
+}
+
+// identityDomainRealmDomain returns the domain of the realm of region, e.g.
+// oraclecloud.com, defaulting to the commercial realm
+func identityDomainRealmDomain(region string) string {
+	if region != "" {
+		ociRegion := oci_common.StringToRegion(region)
+		endpoint := ociRegion.Endpoint("identity")
+		if realmDomain := strings.TrimPrefix(endpoint, "identity."+string(ociRegion)+"."); realmDomain != endpoint {
+			return realmDomain
+		}
+	}
+	return "oraclecloud.com"
+}
+
+// identityDomainListResponse is the SCIM ListResponse envelope
+type identityDomainListResponse struct {
+	TotalResults int               `json:"totalResults"`
+	StartIndex   int               `json:"startIndex"`
+	ItemsPerPage int               `json:"itemsPerPage"`
+	Resources    []json.RawMessage `json:"Resources"`
+}
+
+type identityDomainMeta struct {
+	Created      *string `json:"created"`
+	LastModified *string `json:"lastModified"`
+	ResourceType *string `json:"resourceType"`
+	Location     *string `json:"location"`
+}
+
+// identityDomainRef is a SCIM reference to another resource, e.g. a group member
+type identityDomainRef struct {
+	Value   *string `json:"value"`
+	Display *string `json:"display,omitempty"`
+	Name    *string `json:"name,omitempty"`
+	Type    *string `json:"type,omitempty"`
+	Ocid    *string `json:"ocid,omitempty"`
+	Ref     *string `json:"$ref,omitempty"`
+}
+
+// identityDomainResource holds the attributes common to all SCIM resources
+type identityDomainResource struct {
+	Id              *string            `json:"id"`
+	Ocid            *string            `json:"ocid"`
+	Schemas         []string           `json:"schemas"`
+	Meta            identityDomainMeta `json:"meta"`
+	CompartmentOcid *string            `json:"compartmentOcid"`
+	DomainOcid      *string            `json:"domainOcid"`
+}
+
+// IdentityDomainColumns appends the columns shared by all tables backed by the
+// Identity Domains SCIM API
+func IdentityDomainColumns(columns []*plugin.Column) []*plugin.Column {
+	return append(columns, commonIdentityDomainColumns()...)
+}
+
+func commonIdentityDomainColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "domain_url",
+			Description: "The URL of the identity domain, e.g. https://idcs-abc123.identity.oraclecloud.com:443.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromQual("domain_url"),
+		},
+		{
+			Name:        "ocid",
+			Description: "The OCID of the resource.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("Ocid"),
+		},
+		{
+			Name:        "domain_id",
+			Description: "The OCID of the identity domain.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("DomainOcid"),
+		},
+		{
+			Name:        "time_created",
+			Description: "The date and time the resource was created.",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Transform:   transform.FromField("Meta.Created"),
+		},
+		{
+			Name:        "time_last_modified",
+			Description: "The date and time the resource was last modified.",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Transform:   transform.FromField("Meta.LastModified"),
+		},
+		{
+			Name:        "schemas",
+			Description: "The SCIM schemas the resource conforms to.",
+			Type:        proto.ColumnType_JSON,
+		},
+
+		// Standard OCI columns
+		{
+			Name:        "compartment_id",
+			Description: ColumnDescriptionCompartment,
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("CompartmentOcid"),
+		},
+		{
+			Name:        "tenant_id",
+			Description: ColumnDescriptionTenant,
+			Type:        proto.ColumnType_STRING,
+			Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+			Transform:   transform.FromValue(),
+		},
+	}
+}
+
+// identityDomainScimFilter maps a column to the SCIM attribute it filters on
+type identityDomainScimFilter struct {
+	Column    string
+	Attribute string
+}
+
+var identityDomainScimOperators = map[string]string{
+	"=":  "eq",
+	"<>": "ne",
+	">":  "gt",
+	">=": "ge",
+	"<":  "lt",
+	"<=": "le",
+}
+
+// buildIdentityDomainScimFilter converts the quals on the given columns into a
+// SCIM filter expression, so that they can be evaluated by the service
+func buildIdentityDomainScimFilter(quals plugin.KeyColumnQualMap, filters []identityDomainScimFilter) string {
+	var expressions []string
+
+	for _, filter := range filters {
+		if quals[filter.Column] == nil {
+			continue
+		}
+		for _, q := range quals[filter.Column].Quals {
+			operator, ok := identityDomainScimOperators[q.Operator]
+			if !ok {
+				continue
+			}
+
+			var value string
+			switch v := q.Value.Value.(type) {
+			case *proto.QualValue_StringValue:
+				value = strconv.Quote(v.StringValue)
+			case *proto.QualValue_BoolValue:
+				value = strconv.FormatBool(v.BoolValue)
+			case *proto.QualValue_Int64Value:
+				value = strconv.FormatInt(v.Int64Value, 10)
+			case *proto.QualValue_TimestampValue:
+				value = strconv.Quote(v.TimestampValue.AsTime().UTC().Format(time.RFC3339))
+			default:
+				continue
+			}
+
+			expressions = append(expressions, fmt.Sprintf("%s %s %s", filter.Attribute, operator, value))
+		}
+	}
+
+	return strings.Join(expressions, " and ")
+}
+
+// listIdentityDomainResources pages through a SCIM resource endpoint of the
+// identity domain served at domainUrl, e.g. "Users", calling stream for each
+// resource until it returns false or all pages have been read
+func listIdentityDomainResources(ctx context.Context, d *plugin.QueryData, domainUrl string, resource string, query url.Values, stream func(json.RawMessage) (bool, error)) error {
+	session, err := identityDomainService(ctx, d, domainUrl)
+	if err != nil {
+		return err
+	}
+
+	if query == nil {
+		query = url.Values{}
+	}
+
+	count := identityDomainPageSize
+	if d.QueryContext.Limit != nil && *d.QueryContext.Limit < int64(count) {
+		count = int(*d.QueryContext.Limit)
+	}
+	query.Set("count", strconv.Itoa(count))
+
+	startIndex := 1
+	for {
+		query.Set("startIndex", strconv.Itoa(startIndex))
+
+		var response identityDomainListResponse
+		err := callSignedRequest(ctx, d, session.IdentityDomainClient, "/admin/v1/"+resource, query, &response)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range response.Resources {
+			more, err := stream(item)
+			if err != nil {
+				return err
+			}
+			if !more {
+				return nil
+			}
+		}
+
+		startIndex += len(response.Resources)
+		if len(response.Resources) == 0 || startIndex > response.TotalResults {
+			return nil
+		}
+	}
+}
+
+// getIdentityDomainResource fetches a single SCIM resource by id
+func getIdentityDomainResource(ctx context.Context, d *plugin.QueryData, domainUrl string, resource string, id string, query url.Values, out interface{}) error {
+	session, err := identityDomainService(ctx, d, domainUrl)
+	if err != nil {
+		return err
+	}
+
+	return callSignedRequest(ctx, d, session.IdentityDomainClient, "/admin/v1/"+resource+"/"+url.PathEscape(id), query, out)
+}
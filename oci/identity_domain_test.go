@@ -0,0 +1,48 @@
+package oci
+
+import (
+	"testing"
+)
+
+func TestValidateIdentityDomainUrl(t *testing.T) {
+	tests := []struct {
+		url   string
+		valid bool
+	}{
+		{"https://idcs-abc123.identity.oraclecloud.com:443", true},
+		{"https://idcs-abc123.identity.oraclecloud.com", true},
+		{"https://IDCS-ABC123.identity.oraclecloud.com/", true},
+		{"http://idcs-abc123.identity.oraclecloud.com", false},
+		{"https://idcs-abc123.identity.oraclecloud.com:8443", false},
+		{"https://idcs-abc123.identity.oraclecloud.com.example.com", false},
+		{"https://example.com/idcs-abc123.identity.oraclecloud.com", false},
+		{"https://user@idcs-abc123.identity.oraclecloud.com", false},
+		{"https://idcs-abc123.identity.oraclecloud.com/admin/v1/Users", false},
+		{"https://identity.oraclecloud.com", false},
+		{"https://idcs-abc123.identity.oraclegovcloud.com", false},
+		{"idcs-abc123.identity.oraclecloud.com", false},
+	}
+
+	for _, test := range tests {
+		err := validateIdentityDomainUrl(test.url, "oraclecloud.com")
+		if (err == nil) != test.valid {
+			t.Errorf("%s: expected valid %t, got error %v", test.url, test.valid, err)
+		}
+	}
+}
+
+func TestIdentityDomainRealmDomain(t *testing.T) {
+	tests := map[string]string{
+		"us-ashburn-1":    "oraclecloud.com",
+		"iad":             "oraclecloud.com",
+		"us-langley-1":    "oraclegovcloud.com",
+		"uk-gov-london-1": "oraclegovcloud.uk",
+		"":                "oraclecloud.com",
+	}
+
+	for region, expected := range tests {
+		if actual := identityDomainRealmDomain(region); actual != expected {
+			t.Errorf("%s: expected %s, got %s", region, expected, actual)
+		}
+	}
+}
@@ -0,0 +1,310 @@
+package oci
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// The dynamic group matching rule grammar handled by parseMatchingRule:
+//
+//	rule      := ( "ANY" | "ALL" ) "{" rule { "," rule } "}" | condition
+//	condition := attribute [ ( "=" | "!=" ) value ]
+//	attribute := e.g. instance.id, instance.compartment.id, resource.type,
+//	             resource.id, resource.compartment.id or tag.<namespace>.<key>.value
+//	value     := quoted or bare string
+//
+// Keywords and attribute names are case-insensitive. A condition without a
+// comparison matches when the attribute is present, which is how a rule
+// requires a tag regardless of its value.
+
+const (
+	matchingRuleTokenWord = iota
+	matchingRuleTokenString
+	matchingRuleTokenLeftBrace
+	matchingRuleTokenRightBrace
+	matchingRuleTokenComma
+	matchingRuleTokenEqual
+	matchingRuleTokenNotEqual
+	matchingRuleTokenEOF
+)
+
+type matchingRuleToken struct {
+	kind   int
+	value  string
+	offset int
+}
+
+func (t matchingRuleToken) String() string {
+	if t.kind == matchingRuleTokenEOF {
+		return "end of rule"
+	}
+	return fmt.Sprintf("%q", t.value)
+}
+
+// matchingRule is a parsed matching rule. Either Operator is set and Rules
+// holds the nested rules, or Attribute holds a single condition.
+type matchingRule struct {
+	Operator   string          `json:"operator,omitempty"`
+	Rules      []*matchingRule `json:"rules,omitempty"`
+	Attribute  string          `json:"attribute,omitempty"`
+	Comparator string          `json:"comparator,omitempty"`
+	Value      string          `json:"value,omitempty"`
+}
+
+type matchingRuleParser struct {
+	tokens []matchingRuleToken
+	pos    int
+}
+
+// parseMatchingRule parses the matching rule of a dynamic group
+func parseMatchingRule(rule string) (*matchingRule, error) {
+	tokens, err := scanMatchingRule(rule)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &matchingRuleParser{tokens: tokens}
+	result, err := p.parseRule()
+	if err != nil {
+		return nil, err
+	}
+	if token := p.next(); token.kind != matchingRuleTokenEOF {
+		return nil, fmt.Errorf("expected end of rule, found %s at offset %d", token, token.offset)
+	}
+
+	return result, nil
+}
+
+func scanMatchingRule(src string) ([]matchingRuleToken, error) {
+	var tokens []matchingRuleToken
+
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case unicode.IsSpace(rune(c)):
+			i++
+		case c == '{':
+			tokens = append(tokens, matchingRuleToken{matchingRuleTokenLeftBrace, "{", i})
+			i++
+		case c == '}':
+			tokens = append(tokens, matchingRuleToken{matchingRuleTokenRightBrace, "}", i})
+			i++
+		case c == ',':
+			tokens = append(tokens, matchingRuleToken{matchingRuleTokenComma, ",", i})
+			i++
+		case c == '=':
+			tokens = append(tokens, matchingRuleToken{matchingRuleTokenEqual, "=", i})
+			i++
+		case c == '!' && i+1 < len(src) && src[i+1] == '=':
+			tokens = append(tokens, matchingRuleToken{matchingRuleTokenNotEqual, "!=", i})
+			i += 2
+		case c == '\'' || c == '"':
+			end := strings.IndexByte(src[i+1:], c)
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated string at offset %d", i)
+			}
+			tokens = append(tokens, matchingRuleToken{matchingRuleTokenString, src[i+1 : i+1+end], i})
+			i += end + 2
+		default:
+			start := i
+			for i < len(src) && !unicode.IsSpace(rune(src[i])) && !strings.ContainsRune("{},=!'\"", rune(src[i])) {
+				i++
+			}
+			if i == start {
+				return nil, fmt.Errorf("unexpected %q at offset %d", string(c), i)
+			}
+			tokens = append(tokens, matchingRuleToken{matchingRuleTokenWord, src[start:i], start})
+		}
+	}
+
+	return append(tokens, matchingRuleToken{kind: matchingRuleTokenEOF, offset: len(src)}), nil
+}
+
+func (p *matchingRuleParser) peek() matchingRuleToken {
+	return p.tokens[p.pos]
+}
+
+func (p *matchingRuleParser) next() matchingRuleToken {
+	token := p.tokens[p.pos]
+	if token.kind != matchingRuleTokenEOF {
+		p.pos++
+	}
+	return token
+}
+
+func (p *matchingRuleParser) parseRule() (*matchingRule, error) {
+	token := p.next()
+	if token.kind != matchingRuleTokenWord {
+		return nil, fmt.Errorf("expected ANY, ALL or an attribute, found %s at offset %d", token, token.offset)
+	}
+
+	operator := strings.ToLower(token.value)
+	if (operator == "any" || operator == "all") && p.peek().kind == matchingRuleTokenLeftBrace {
+		p.next()
+		result := &matchingRule{Operator: operator}
+		for {
+			rule, err := p.parseRule()
+			if err != nil {
+				return nil, err
+			}
+			result.Rules = append(result.Rules, rule)
+
+			token := p.next()
+			switch token.kind {
+			case matchingRuleTokenComma:
+				continue
+			case matchingRuleTokenRightBrace:
+				return result, nil
+			default:
+				return nil, fmt.Errorf("expected \",\" or \"}\", found %s at offset %d", token, token.offset)
+			}
+		}
+	}
+
+	result := &matchingRule{Attribute: strings.ToLower(token.value)}
+	switch p.peek().kind {
+	case matchingRuleTokenEqual, matchingRuleTokenNotEqual:
+		result.Comparator = p.next().value
+		value := p.next()
+		if value.kind != matchingRuleTokenString && value.kind != matchingRuleTokenWord {
+			return nil, fmt.Errorf("expected a value, found %s at offset %d", value, value.offset)
+		}
+		result.Value = value.value
+	}
+
+	return result, nil
+}
+
+// matchingRuleAttributes looks up the value of a matching rule attribute for
+// a candidate principal
+type matchingRuleAttributes func(attribute string) (string, bool)
+
+// evaluate reports whether the principal described by attributes matches the rule
+func (r *matchingRule) evaluate(attributes matchingRuleAttributes) bool {
+	switch r.Operator {
+	case "any":
+		for _, rule := range r.Rules {
+			if rule.evaluate(attributes) {
+				return true
+			}
+		}
+		return false
+	case "all":
+		for _, rule := range r.Rules {
+			if !rule.evaluate(attributes) {
+				return false
+			}
+		}
+		return true
+	}
+
+	// Attributes that do not apply to the principal never match
+	value, ok := attributes(r.Attribute)
+	if !ok {
+		return false
+	}
+
+	// Resource types are case-insensitive, e.g. fnfunc or fnFunc
+	equal := value == r.Value
+	if r.Attribute == "resource.type" {
+		equal = strings.EqualFold(value, r.Value)
+	}
+
+	switch r.Comparator {
+	case "=":
+		return equal
+	case "!=":
+		return !equal
+	}
+	return true
+}
+
+// references reports whether any condition of the rule uses an attribute
+// with the given prefix
+func (r *matchingRule) references(prefix string) bool {
+	if r.Operator == "" {
+		return strings.HasPrefix(r.Attribute, prefix)
+	}
+	for _, rule := range r.Rules {
+		if rule.references(prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchingRuleSupportedAttributes lists the attributes evaluated by the plugin,
+// apart from tag.<namespace>.<key>.value
+var matchingRuleSupportedAttributes = map[string]bool{
+	"instance.id":             true,
+	"instance.compartment.id": true,
+	"resource.type":           true,
+	"resource.id":             true,
+	"resource.compartment.id": true,
+}
+
+// matchingRuleSupportedResourceTypes lists the resource principal types whose
+// resources are evaluated by the plugin
+var matchingRuleSupportedResourceTypes = map[string]bool{
+	"fnfunc": true,
+}
+
+// unsupported returns the attributes and resource types used by the rule that
+// the plugin doesn't evaluate, e.g. "attribute instance.shape" or
+// "resource.type datasciencenotebooksession". Conditions on them never match,
+// so the rule may have members that are not found.
+func (r *matchingRule) unsupported() []string {
+	seen := map[string]bool{}
+	var items []string
+	var walk func(rule *matchingRule)
+	walk = func(rule *matchingRule) {
+		for _, nested := range rule.Rules {
+			walk(nested)
+		}
+		if rule.Operator != "" {
+			return
+		}
+
+		var item string
+		switch {
+		case rule.Attribute == "resource.type" && rule.Comparator == "=" && !matchingRuleSupportedResourceTypes[strings.ToLower(rule.Value)]:
+			item = "resource.type " + strings.ToLower(rule.Value)
+		case matchingRuleSupportedAttributes[rule.Attribute]:
+		case strings.HasPrefix(rule.Attribute, "tag.") && strings.HasSuffix(rule.Attribute, ".value") && strings.Count(rule.Attribute, ".") == 3:
+		default:
+			item = "attribute " + rule.Attribute
+		}
+		if item != "" && !seen[item] {
+			seen[item] = true
+			items = append(items, item)
+		}
+	}
+	walk(r)
+
+	sort.Strings(items)
+	return items
+}
+
+// matchingRuleTagAttributes resolves tag.<namespace>.<key>.value attributes
+// from the defined tags of a resource
+func matchingRuleTagAttributes(attribute string, definedTags map[string]map[string]interface{}) (string, bool) {
+	parts := strings.Split(attribute, ".")
+	if len(parts) != 4 || parts[0] != "tag" || parts[3] != "value" {
+		return "", false
+	}
+
+	for namespace, tags := range definedTags {
+		if !strings.EqualFold(namespace, parts[1]) {
+			continue
+		}
+		for key, value := range tags {
+			if strings.EqualFold(key, parts[2]) {
+				return fmt.Sprint(value), true
+			}
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,146 @@
+package oci
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestParseMatchingRule(t *testing.T) {
+	tests := []struct {
+		rule string
+		// the expected rule, as JSON
+		expected string
+	}{
+		{
+			rule:     "instance.compartment.id = 'ocid1.compartment.oc1..aaaa'",
+			expected: `{"attribute":"instance.compartment.id","comparator":"=","value":"ocid1.compartment.oc1..aaaa"}`,
+		},
+		{
+			rule:     `Instance.Id != "ocid1.instance.oc1.iad.aaaa"`,
+			expected: `{"attribute":"instance.id","comparator":"!=","value":"ocid1.instance.oc1.iad.aaaa"}`,
+		},
+		{
+			rule:     "tag.Operations.Role.value",
+			expected: `{"attribute":"tag.operations.role.value"}`,
+		},
+		{
+			rule:     "ANY {instance.compartment.id = 'ocid1.compartment.oc1..aaaa', instance.compartment.id = 'ocid1.compartment.oc1..bbbb'}",
+			expected: `{"operator":"any","rules":[{"attribute":"instance.compartment.id","comparator":"=","value":"ocid1.compartment.oc1..aaaa"},{"attribute":"instance.compartment.id","comparator":"=","value":"ocid1.compartment.oc1..bbbb"}]}`,
+		},
+		{
+			rule:     "all {resource.type = fnfunc, any {resource.compartment.id = 'ocid1.compartment.oc1..aaaa', tag.ops.env.value = 'prod'}}",
+			expected: `{"operator":"all","rules":[{"attribute":"resource.type","comparator":"=","value":"fnfunc"},{"operator":"any","rules":[{"attribute":"resource.compartment.id","comparator":"=","value":"ocid1.compartment.oc1..aaaa"},{"attribute":"tag.ops.env.value","comparator":"=","value":"prod"}]}]}`,
+		},
+		{
+			// ANY without a brace is an attribute named any
+			rule:     "any = 'x'",
+			expected: `{"attribute":"any","comparator":"=","value":"x"}`,
+		},
+	}
+
+	for _, test := range tests {
+		result, err := parseMatchingRule(test.rule)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.rule, err)
+			continue
+		}
+		actual, err := json.Marshal(result)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(actual) != test.expected {
+			t.Errorf("%s:\n got %s\nwant %s", test.rule, actual, test.expected)
+		}
+	}
+}
+
+func TestParseMatchingRuleErrors(t *testing.T) {
+	tests := []struct {
+		rule  string
+		error string
+	}{
+		{"", "expected ANY, ALL or an attribute, found end of rule"},
+		{"instance.id = 'ocid1.instance.oc1.iad.aaaa", "unterminated string at offset 14"},
+		{"instance.id = ", "expected a value, found end of rule"},
+		{"instance.id = {", "expected a value, found \"{\""},
+		{"ANY {instance.id = 'a' instance.id = 'b'}", "expected \",\" or \"}\", found \"instance.id\""},
+		{"ANY {instance.id = 'a',", "expected ANY, ALL or an attribute, found end of rule"},
+		{"instance.id = 'a' instance.id = 'b'", "expected end of rule, found \"instance.id\""},
+		{"instance.id ! 'a'", "unexpected \"!\" at offset 12"},
+	}
+
+	for _, test := range tests {
+		_, err := parseMatchingRule(test.rule)
+		if err == nil {
+			t.Errorf("%s: expected an error", test.rule)
+			continue
+		}
+		if !strings.Contains(err.Error(), test.error) {
+			t.Errorf("%s: expected error containing %q, got %q", test.rule, test.error, err)
+		}
+	}
+}
+
+func TestMatchingRuleEvaluate(t *testing.T) {
+	function := func(attribute string) (string, bool) {
+		switch attribute {
+		case "resource.type":
+			return "fnfunc", true
+		case "resource.compartment.id":
+			return "ocid1.compartment.oc1..aaaa", true
+		}
+		return matchingRuleTagAttributes(attribute, map[string]map[string]interface{}{
+			"Ops": {"Env": "prod"},
+		})
+	}
+
+	tests := []struct {
+		rule     string
+		expected bool
+	}{
+		{"resource.type = 'fnFunc'", true},
+		{"resource.type != 'fnfunc'", false},
+		{"resource.compartment.id = 'ocid1.compartment.oc1..bbbb'", false},
+		{"instance.compartment.id != 'ocid1.compartment.oc1..bbbb'", false},
+		{"tag.ops.env.value", true},
+		{"tag.ops.env.value = 'prod'", true},
+		{"tag.ops.owner.value", false},
+		{"ALL {resource.type = 'fnfunc', resource.compartment.id = 'ocid1.compartment.oc1..aaaa'}", true},
+		{"ALL {resource.type = 'fnfunc', resource.compartment.id = 'ocid1.compartment.oc1..bbbb'}", false},
+		{"ANY {instance.id = 'ocid1.instance.oc1.iad.aaaa', tag.ops.env.value = 'prod'}", true},
+		{"ANY {instance.id = 'ocid1.instance.oc1.iad.aaaa', tag.ops.env.value = 'dev'}", false},
+	}
+
+	for _, test := range tests {
+		rule, err := parseMatchingRule(test.rule)
+		if err != nil {
+			t.Fatalf("%s: unexpected error %v", test.rule, err)
+		}
+		if actual := rule.evaluate(function); actual != test.expected {
+			t.Errorf("%s: expected %t, got %t", test.rule, test.expected, actual)
+		}
+	}
+}
+
+func TestMatchingRuleUnsupported(t *testing.T) {
+	tests := []struct {
+		rule     string
+		expected string
+	}{
+		{"ANY {instance.compartment.id = 'a', resource.type = 'fnfunc', tag.ops.env.value}", ""},
+		{"resource.type != 'apigateway'", ""},
+		{"ALL {resource.type = 'ApiGateway', resource.compartment.id = 'a'}", "resource.type apigateway"},
+		{"ANY {resource.type = 'datasciencenotebooksession', instance.shape = 'a', ANY {instance.shape = 'b', tag.ops.value}}", "attribute instance.shape,attribute tag.ops.value,resource.type datasciencenotebooksession"},
+	}
+
+	for _, test := range tests {
+		rule, err := parseMatchingRule(test.rule)
+		if err != nil {
+			t.Fatalf("%s: unexpected error %v", test.rule, err)
+		}
+		if actual := strings.Join(rule.unsupported(), ","); actual != test.expected {
+			t.Errorf("%s: expected %q, got %q", test.rule, test.expected, actual)
+		}
+	}
+}
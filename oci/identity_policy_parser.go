@@ -0,0 +1,405 @@
+package oci
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// The policy language grammar handled by parsePolicyStatement:
+//
+//	statement  := action subjects [ "of" "tenancy" name ] "to" access location [ "where" conditions ]
+//	            | "define" name name "as" name
+//	action     := "allow" | "deny" | "endorse" | "admit"
+//	subjects   := "any-user" | "any-group" | kind subject { "," [ kind ] subject }
+//	kind       := "group" | "dynamic-group" | "service"
+//	subject    := [ "id" ] name
+//	access     := verb resource | "{" permission { "," permission } "}"
+//	verb       := "inspect" | "read" | "use" | "manage"
+//	location   := "in" ( "tenancy" [ name ] | "any-tenancy" | "compartment" [ "id" ] name )
+//
+// Keywords are case-insensitive. A name may be quoted with single or double
+// quotes, and quoted segments may be joined with "/" to qualify a group with
+// its identity domain, e.g. 'Default'/'Administrators'. The conditions of a
+// where clause are kept verbatim.
+
+const (
+	policyTokenWord = iota
+	policyTokenComma
+	policyTokenLeftBrace
+	policyTokenRightBrace
+	policyTokenEOF
+)
+
+type policyToken struct {
+	kind   int
+	value  string
+	offset int
+}
+
+func (t policyToken) String() string {
+	if t.kind == policyTokenEOF {
+		return "end of statement"
+	}
+	return fmt.Sprintf("%q", t.value)
+}
+
+// keyword returns the lower-cased value of an unquoted word token
+func (t policyToken) keyword() string {
+	if t.kind != policyTokenWord {
+		return ""
+	}
+	return strings.ToLower(t.value)
+}
+
+// policyLexer splits a statement into tokens on demand, so the text of a
+// where clause is never tokenized
+type policyLexer struct {
+	src    string
+	offset int
+	peeked *policyToken
+}
+
+func (l *policyLexer) peek() (policyToken, error) {
+	if l.peeked == nil {
+		token, err := l.scan()
+		if err != nil {
+			return token, err
+		}
+		l.peeked = &token
+	}
+	return *l.peeked, nil
+}
+
+func (l *policyLexer) next() (policyToken, error) {
+	token, err := l.peek()
+	l.peeked = nil
+	return token, err
+}
+
+// rest returns the unconsumed text of the statement
+func (l *policyLexer) rest() string {
+	return strings.TrimSpace(l.src[l.offset:])
+}
+
+func (l *policyLexer) scan() (policyToken, error) {
+	for l.offset < len(l.src) && unicode.IsSpace(rune(l.src[l.offset])) {
+		l.offset++
+	}
+	start := l.offset
+	if l.offset >= len(l.src) {
+		return policyToken{kind: policyTokenEOF, offset: start}, nil
+	}
+
+	switch l.src[l.offset] {
+	case ',':
+		l.offset++
+		return policyToken{kind: policyTokenComma, value: ",", offset: start}, nil
+	case '{':
+		l.offset++
+		return policyToken{kind: policyTokenLeftBrace, value: "{", offset: start}, nil
+	case '}':
+		l.offset++
+		return policyToken{kind: policyTokenRightBrace, value: "}", offset: start}, nil
+	}
+
+	var value strings.Builder
+	for l.offset < len(l.src) {
+		c := l.src[l.offset]
+		if unicode.IsSpace(rune(c)) || c == ',' || c == '{' || c == '}' {
+			break
+		}
+		if c == '\'' || c == '"' {
+			end := strings.IndexByte(l.src[l.offset+1:], c)
+			if end < 0 {
+				return policyToken{}, fmt.Errorf("unterminated quoted name at offset %d", l.offset)
+			}
+			value.WriteString(l.src[l.offset+1 : l.offset+1+end])
+			l.offset += end + 2
+			continue
+		}
+		value.WriteByte(c)
+		l.offset++
+	}
+
+	return policyToken{kind: policyTokenWord, value: value.String(), offset: start}, nil
+}
+
+// policyStatement is the parsed form of a single policy statement
+type policyStatement struct {
+	Action         string
+	SubjectType    string
+	Subjects       []string
+	SubjectTenancy string
+	Verb           string
+	Permissions    []string
+	Resource       string
+	LocationType   string
+	Location       string
+	Conditions     string
+	DefinedAs      string
+}
+
+type policyParser struct {
+	lexer policyLexer
+}
+
+// parsePolicyStatement parses a policy statement written in the OCI policy language
+func parsePolicyStatement(statement string) (*policyStatement, error) {
+	p := &policyParser{lexer: policyLexer{src: statement}}
+	return p.parse()
+}
+
+func (p *policyParser) parse() (*policyStatement, error) {
+	result := &policyStatement{}
+
+	token, err := p.lexer.next()
+	if err != nil {
+		return nil, err
+	}
+	result.Action = token.keyword()
+
+	switch result.Action {
+	case "define":
+		return p.parseDefine(result)
+	case "allow", "deny", "endorse", "admit":
+	default:
+		return nil, fmt.Errorf("expected allow, deny, endorse, admit or define, found %s at offset %d", token, token.offset)
+	}
+
+	if err := p.parseSubjects(result); err != nil {
+		return nil, err
+	}
+
+	if result.Action == "admit" {
+		if err := p.expectKeyword("of", "tenancy"); err != nil {
+			return nil, err
+		}
+		if result.SubjectTenancy, err = p.expectName(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.expectKeyword("to"); err != nil {
+		return nil, err
+	}
+	if err := p.parseAccess(result); err != nil {
+		return nil, err
+	}
+	if err := p.parseLocation(result); err != nil {
+		return nil, err
+	}
+
+	token, err = p.lexer.next()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case token.kind == policyTokenEOF:
+	case token.keyword() == "where":
+		result.Conditions = p.lexer.rest()
+		if result.Conditions == "" {
+			return nil, fmt.Errorf("expected conditions after where at offset %d", token.offset)
+		}
+	default:
+		return nil, fmt.Errorf("expected where or end of statement, found %s at offset %d", token, token.offset)
+	}
+
+	return result, nil
+}
+
+func (p *policyParser) parseDefine(result *policyStatement) (*policyStatement, error) {
+	token, err := p.lexer.next()
+	if err != nil {
+		return nil, err
+	}
+	switch token.keyword() {
+	case "tenancy", "group", "dynamic-group":
+		result.SubjectType = token.keyword()
+	default:
+		return nil, fmt.Errorf("expected tenancy, group or dynamic-group, found %s at offset %d", token, token.offset)
+	}
+
+	alias, err := p.expectName()
+	if err != nil {
+		return nil, err
+	}
+	result.Subjects = []string{alias}
+
+	if err := p.expectKeyword("as"); err != nil {
+		return nil, err
+	}
+	if result.DefinedAs, err = p.expectName(); err != nil {
+		return nil, err
+	}
+
+	return result, p.expectEOF()
+}
+
+func (p *policyParser) parseSubjects(result *policyStatement) error {
+	token, err := p.lexer.next()
+	if err != nil {
+		return err
+	}
+	result.SubjectType = token.keyword()
+
+	switch result.SubjectType {
+	case "any-user", "any-group":
+		return nil
+	case "group", "dynamic-group", "service":
+	default:
+		return fmt.Errorf("expected group, dynamic-group, service, any-user or any-group, found %s at offset %d", token, token.offset)
+	}
+
+	for {
+		token, err := p.lexer.peek()
+		if err != nil {
+			return err
+		}
+		// the subject kind may be repeated after a comma, e.g. "group A, group B"
+		if len(result.Subjects) > 0 {
+			switch token.keyword() {
+			case result.SubjectType:
+				p.lexer.next()
+			case "group", "dynamic-group", "service", "any-user", "any-group":
+				return fmt.Errorf("cannot mix subject types %s and %s at offset %d", result.SubjectType, token.keyword(), token.offset)
+			}
+		}
+
+		// a subject may be referenced by OCID rather than by name
+		if token, err := p.lexer.peek(); err == nil && token.keyword() == "id" {
+			p.lexer.next()
+		}
+
+		name, err := p.expectName()
+		if err != nil {
+			return err
+		}
+		result.Subjects = append(result.Subjects, name)
+
+		token, err = p.lexer.peek()
+		if err != nil {
+			return err
+		}
+		if token.kind != policyTokenComma {
+			return nil
+		}
+		p.lexer.next()
+	}
+}
+
+func (p *policyParser) parseAccess(result *policyStatement) error {
+	token, err := p.lexer.next()
+	if err != nil {
+		return err
+	}
+
+	if token.kind == policyTokenLeftBrace {
+		for {
+			permission, err := p.expectName()
+			if err != nil {
+				return err
+			}
+			result.Permissions = append(result.Permissions, permission)
+
+			token, err := p.lexer.next()
+			if err != nil {
+				return err
+			}
+			switch token.kind {
+			case policyTokenComma:
+				continue
+			case policyTokenRightBrace:
+				return nil
+			default:
+				return fmt.Errorf("expected \",\" or \"}\", found %s at offset %d", token, token.offset)
+			}
+		}
+	}
+
+	switch token.keyword() {
+	case "inspect", "read", "use", "manage":
+		result.Verb = token.keyword()
+	default:
+		return fmt.Errorf("expected inspect, read, use, manage or a permission list, found %s at offset %d", token, token.offset)
+	}
+
+	resource, err := p.expectName()
+	if err != nil {
+		return err
+	}
+	result.Resource = strings.ToLower(resource)
+
+	return nil
+}
+
+func (p *policyParser) parseLocation(result *policyStatement) error {
+	if err := p.expectKeyword("in"); err != nil {
+		return err
+	}
+
+	token, err := p.lexer.next()
+	if err != nil {
+		return err
+	}
+	result.LocationType = token.keyword()
+
+	switch result.LocationType {
+	case "any-tenancy":
+		return nil
+	case "tenancy":
+		// endorse and admit statements may name the tenancy by its alias
+		token, err := p.lexer.peek()
+		if err != nil {
+			return err
+		}
+		if token.kind == policyTokenWord && token.keyword() != "where" {
+			p.lexer.next()
+			result.Location = token.value
+		}
+		return nil
+	case "compartment":
+		if token, err := p.lexer.peek(); err == nil && token.keyword() == "id" {
+			p.lexer.next()
+		}
+		result.Location, err = p.expectName()
+		return err
+	}
+
+	return fmt.Errorf("expected tenancy, any-tenancy or compartment, found %s at offset %d", token, token.offset)
+}
+
+func (p *policyParser) expectKeyword(keywords ...string) error {
+	for _, keyword := range keywords {
+		token, err := p.lexer.next()
+		if err != nil {
+			return err
+		}
+		if token.keyword() != keyword {
+			return fmt.Errorf("expected %q, found %s at offset %d", keyword, token, token.offset)
+		}
+	}
+	return nil
+}
+
+func (p *policyParser) expectName() (string, error) {
+	token, err := p.lexer.next()
+	if err != nil {
+		return "", err
+	}
+	if token.kind != policyTokenWord || token.value == "" {
+		return "", fmt.Errorf("expected a name, found %s at offset %d", token, token.offset)
+	}
+	return token.value, nil
+}
+
+func (p *policyParser) expectEOF() error {
+	token, err := p.lexer.next()
+	if err != nil {
+		return err
+	}
+	if token.kind != policyTokenEOF {
+		return fmt.Errorf("expected end of statement, found %s at offset %d", token, token.offset)
+	}
+	return nil
+}
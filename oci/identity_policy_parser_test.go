@@ -0,0 +1,229 @@
+package oci
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParsePolicyStatement(t *testing.T) {
+	tests := []struct {
+		statement string
+		expected  policyStatement
+	}{
+		{
+			statement: "Allow group Administrators to manage all-resources in tenancy",
+			expected: policyStatement{
+				Action:       "allow",
+				SubjectType:  "group",
+				Subjects:     []string{"Administrators"},
+				Verb:         "manage",
+				Resource:     "all-resources",
+				LocationType: "tenancy",
+			},
+		},
+		{
+			statement: "allow group NetworkAdmins, group 'Security Admins' to use virtual-network-family in compartment Prod:Network",
+			expected: policyStatement{
+				Action:       "allow",
+				SubjectType:  "group",
+				Subjects:     []string{"NetworkAdmins", "Security Admins"},
+				Verb:         "use",
+				Resource:     "virtual-network-family",
+				LocationType: "compartment",
+				Location:     "Prod:Network",
+			},
+		},
+		{
+			statement: "Allow group 'Default'/'Administrators', Auditors to READ Buckets in compartment id ocid1.compartment.oc1..aaaa",
+			expected: policyStatement{
+				Action:       "allow",
+				SubjectType:  "group",
+				Subjects:     []string{"Default/Administrators", "Auditors"},
+				Verb:         "read",
+				Resource:     "buckets",
+				LocationType: "compartment",
+				Location:     "ocid1.compartment.oc1..aaaa",
+			},
+		},
+		{
+			statement: "allow group id ocid1.group.oc1..aaaa to inspect instances in tenancy",
+			expected: policyStatement{
+				Action:       "allow",
+				SubjectType:  "group",
+				Subjects:     []string{"ocid1.group.oc1..aaaa"},
+				Verb:         "inspect",
+				Resource:     "instances",
+				LocationType: "tenancy",
+			},
+		},
+		{
+			statement: "allow dynamic-group InstancePrincipals to read secret-family in compartment Apps where target.secret.name = 'db-password'",
+			expected: policyStatement{
+				Action:       "allow",
+				SubjectType:  "dynamic-group",
+				Subjects:     []string{"InstancePrincipals"},
+				Verb:         "read",
+				Resource:     "secret-family",
+				LocationType: "compartment",
+				Location:     "Apps",
+				Conditions:   "target.secret.name = 'db-password'",
+			},
+		},
+		{
+			statement: "allow any-user to read objects in compartment Public where all {request.principal.type = 'fnfunc', target.bucket.name = 'assets'}",
+			expected: policyStatement{
+				Action:       "allow",
+				SubjectType:  "any-user",
+				Verb:         "read",
+				Resource:     "objects",
+				LocationType: "compartment",
+				Location:     "Public",
+				Conditions:   "all {request.principal.type = 'fnfunc', target.bucket.name = 'assets'}",
+			},
+		},
+		{
+			statement: "allow any-group to inspect compartments in tenancy",
+			expected: policyStatement{
+				Action:       "allow",
+				SubjectType:  "any-group",
+				Verb:         "inspect",
+				Resource:     "compartments",
+				LocationType: "tenancy",
+			},
+		},
+		{
+			statement: "allow service objectstorage-us-ashburn-1 to use keys in tenancy",
+			expected: policyStatement{
+				Action:       "allow",
+				SubjectType:  "service",
+				Subjects:     []string{"objectstorage-us-ashburn-1"},
+				Verb:         "use",
+				Resource:     "keys",
+				LocationType: "tenancy",
+			},
+		},
+		{
+			statement: "allow group Operators to {INSTANCE_READ, INSTANCE_POWER_ACTIONS} in compartment Prod",
+			expected: policyStatement{
+				Action:       "allow",
+				SubjectType:  "group",
+				Subjects:     []string{"Operators"},
+				Permissions:  []string{"INSTANCE_READ", "INSTANCE_POWER_ACTIONS"},
+				LocationType: "compartment",
+				Location:     "Prod",
+			},
+		},
+		{
+			statement: "deny group Contractors to manage buckets in tenancy",
+			expected: policyStatement{
+				Action:       "deny",
+				SubjectType:  "group",
+				Subjects:     []string{"Contractors"},
+				Verb:         "manage",
+				Resource:     "buckets",
+				LocationType: "tenancy",
+			},
+		},
+		{
+			statement: "endorse group Admins to manage object-family in tenancy Partner",
+			expected: policyStatement{
+				Action:       "endorse",
+				SubjectType:  "group",
+				Subjects:     []string{"Admins"},
+				Verb:         "manage",
+				Resource:     "object-family",
+				LocationType: "tenancy",
+				Location:     "Partner",
+			},
+		},
+		{
+			statement: "endorse any-user to read buckets in any-tenancy",
+			expected: policyStatement{
+				Action:       "endorse",
+				SubjectType:  "any-user",
+				Verb:         "read",
+				Resource:     "buckets",
+				LocationType: "any-tenancy",
+			},
+		},
+		{
+			statement: "admit group Admins of tenancy Partner to read buckets in compartment Shared",
+			expected: policyStatement{
+				Action:         "admit",
+				SubjectType:    "group",
+				Subjects:       []string{"Admins"},
+				SubjectTenancy: "Partner",
+				Verb:           "read",
+				Resource:       "buckets",
+				LocationType:   "compartment",
+				Location:       "Shared",
+			},
+		},
+		{
+			statement: "define tenancy Partner as ocid1.tenancy.oc1..aaaa",
+			expected: policyStatement{
+				Action:      "define",
+				SubjectType: "tenancy",
+				Subjects:    []string{"Partner"},
+				DefinedAs:   "ocid1.tenancy.oc1..aaaa",
+			},
+		},
+		{
+			statement: "Define group PartnerAdmins as ocid1.group.oc1..aaaa",
+			expected: policyStatement{
+				Action:      "define",
+				SubjectType: "group",
+				Subjects:    []string{"PartnerAdmins"},
+				DefinedAs:   "ocid1.group.oc1..aaaa",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		result, err := parsePolicyStatement(test.statement)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.statement, err)
+			continue
+		}
+		if !reflect.DeepEqual(*result, test.expected) {
+			t.Errorf("%s:\n got %+v\nwant %+v", test.statement, *result, test.expected)
+		}
+	}
+}
+
+func TestParsePolicyStatementErrors(t *testing.T) {
+	tests := []struct {
+		statement string
+		error     string
+	}{
+		{"", "expected allow, deny, endorse, admit or define, found end of statement"},
+		{"permit group A to read buckets in tenancy", "expected allow, deny, endorse, admit or define"},
+		{"allow user A to read buckets in tenancy", "expected group, dynamic-group, service, any-user or any-group"},
+		{"allow group A, dynamic-group B to read buckets in tenancy", "cannot mix subject types group and dynamic-group"},
+		{"allow group 'A to read buckets in tenancy", "unterminated quoted name"},
+		{"allow group A read buckets in tenancy", "expected \"to\", found \"read\""},
+		{"allow group A to write buckets in tenancy", "expected inspect, read, use, manage or a permission list"},
+		{"allow group A to read in tenancy", "expected \"in\", found \"tenancy\""},
+		{"allow group A to {BUCKET_READ BUCKET_INSPECT} in tenancy", "expected \",\" or \"}\""},
+		{"allow group A to read buckets", "expected \"in\", found end of statement"},
+		{"allow group A to read buckets in region us-ashburn-1", "expected tenancy, any-tenancy or compartment"},
+		{"allow group A to read buckets in compartment", "expected a name, found end of statement"},
+		{"allow group A to read buckets in compartment B where", "expected conditions after where"},
+		{"allow group A to read buckets in compartment B C", "expected where or end of statement"},
+		{"admit group A to read buckets in tenancy", "expected \"of\", found \"to\""},
+		{"define user A as ocid1.user.oc1..aaaa", "expected tenancy, group or dynamic-group"},
+		{"define group A as ocid1.group.oc1..aaaa extra", "expected end of statement"},
+	}
+
+	for _, test := range tests {
+		_, err := parsePolicyStatement(test.statement)
+		if err == nil {
+			t.Errorf("%s: expected an error", test.statement)
+			continue
+		}
+		if !strings.Contains(err.Error(), test.error) {
+			t.Errorf("%s: expected error containing %q, got %q", test.statement, test.error, err)
+		}
+	}
+}
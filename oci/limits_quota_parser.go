@@ -0,0 +1,128 @@
+package oci
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// The quota language grammar handled by parseQuotaStatement:
+//
+//	statement := action family quotas [ "to" value ] location [ "where" conditions ]
+//	action    := "set" | "unset" | "zero"
+//	quotas    := "quotas" | "quota" name { "," name }
+//	location  := "in" ( "tenancy" | "compartment" [ "id" ] name )
+//
+// A quota name may be a regular expression delimited by slashes, e.g.
+// /.*-count/. The statement is tokenized with the policy language lexer, so
+// keywords are case-insensitive and names may be quoted. The conditions of a
+// where clause are kept verbatim.
+
+// quotaStatement is the parsed form of a single quota statement
+type quotaStatement struct {
+	Action       string   `json:"action"`
+	Family       string   `json:"family"`
+	Quotas       []string `json:"quotas"`
+	Value        *float64 `json:"value,omitempty"`
+	LocationType string   `json:"location_type"`
+	Location     string   `json:"location,omitempty"`
+	Conditions   string   `json:"conditions,omitempty"`
+}
+
+// parseQuotaStatement parses a quota statement written in the OCI quota language
+func parseQuotaStatement(statement string) (*quotaStatement, error) {
+	p := &policyParser{lexer: policyLexer{src: statement}}
+	result := &quotaStatement{}
+
+	token, err := p.lexer.next()
+	if err != nil {
+		return nil, err
+	}
+	result.Action = token.keyword()
+	switch result.Action {
+	case "set", "unset", "zero":
+	default:
+		return nil, fmt.Errorf("expected set, unset or zero, found %s at offset %d", token, token.offset)
+	}
+
+	if result.Family, err = p.expectName(); err != nil {
+		return nil, err
+	}
+
+	// either all quotas in the family, or a list of quota names
+	token, err = p.lexer.next()
+	if err != nil {
+		return nil, err
+	}
+	switch token.keyword() {
+	case "quotas":
+	case "quota":
+		for {
+			name, err := p.expectName()
+			if err != nil {
+				return nil, err
+			}
+			result.Quotas = append(result.Quotas, name)
+			if token, err := p.lexer.peek(); err != nil || token.kind != policyTokenComma {
+				break
+			}
+			p.lexer.next()
+		}
+	default:
+		return nil, fmt.Errorf("expected quota or quotas, found %s at offset %d", token, token.offset)
+	}
+
+	// only set statements carry a value
+	if result.Action == "set" {
+		if err := p.expectKeyword("to"); err != nil {
+			return nil, err
+		}
+		token, err := p.lexer.next()
+		if err != nil {
+			return nil, err
+		}
+		value, err := strconv.ParseFloat(token.value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("expected a quota value, found %s at offset %d", token, token.offset)
+		}
+		result.Value = &value
+	}
+
+	if err := p.expectKeyword("in"); err != nil {
+		return nil, err
+	}
+	token, err = p.lexer.next()
+	if err != nil {
+		return nil, err
+	}
+	result.LocationType = token.keyword()
+	switch result.LocationType {
+	case "tenancy":
+	case "compartment":
+		if token, err := p.lexer.peek(); err == nil && token.keyword() == "id" {
+			p.lexer.next()
+		}
+		if result.Location, err = p.expectName(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("expected tenancy or compartment, found %s at offset %d", token, token.offset)
+	}
+
+	token, err = p.lexer.peek()
+	if err != nil {
+		return nil, err
+	}
+	if token.keyword() == "where" {
+		p.lexer.next()
+		result.Conditions = p.lexer.rest()
+		if result.Conditions == "" {
+			return nil, fmt.Errorf("expected conditions after where at offset %d", token.offset)
+		}
+		return result, nil
+	}
+
+	if err := p.expectEOF(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
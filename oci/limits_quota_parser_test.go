@@ -0,0 +1,92 @@
+package oci
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestParseQuotaStatement(t *testing.T) {
+	tests := []struct {
+		statement string
+		// the expected statement, as JSON
+		expected string
+	}{
+		{
+			statement: "set compute-core quota standard-e4-core-count to 10 in tenancy",
+			expected:  `{"action":"set","family":"compute-core","quotas":["standard-e4-core-count"],"value":10,"location_type":"tenancy"}`,
+		},
+		{
+			statement: "Set database quota adb-free-count, adb-ocpu-count To 2.5 In Compartment Prod:Data",
+			expected:  `{"action":"set","family":"database","quotas":["adb-free-count","adb-ocpu-count"],"value":2.5,"location_type":"compartment","location":"Prod:Data"}`,
+		},
+		{
+			statement: "set compute-core quota /standard-.*-core-count/ to 0 in compartment id ocid1.compartment.oc1..aaaa where request.region = 'us-phoenix-1'",
+			expected:  `{"action":"set","family":"compute-core","quotas":["/standard-.*-core-count/"],"value":0,"location_type":"compartment","location":"ocid1.compartment.oc1..aaaa","conditions":"request.region = 'us-phoenix-1'"}`,
+		},
+		{
+			statement: "zero compute-core quotas in tenancy",
+			expected:  `{"action":"zero","family":"compute-core","quotas":null,"location_type":"tenancy"}`,
+		},
+		{
+			statement: "zero compute-memory quota standard-e4-memory-count in compartment 'Dev Sandbox' where request.region = us-ashburn-1",
+			expected:  `{"action":"zero","family":"compute-memory","quotas":["standard-e4-memory-count"],"location_type":"compartment","location":"Dev Sandbox","conditions":"request.region = us-ashburn-1"}`,
+		},
+		{
+			statement: "unset compute-core quotas in compartment Dev",
+			expected:  `{"action":"unset","family":"compute-core","quotas":null,"location_type":"compartment","location":"Dev"}`,
+		},
+		{
+			statement: "unset database quota adb-ocpu-count in tenancy where request.region = 'us-ashburn-1'",
+			expected:  `{"action":"unset","family":"database","quotas":["adb-ocpu-count"],"location_type":"tenancy","conditions":"request.region = 'us-ashburn-1'"}`,
+		},
+	}
+
+	for _, test := range tests {
+		result, err := parseQuotaStatement(test.statement)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.statement, err)
+			continue
+		}
+		actual, err := json.Marshal(result)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(actual) != test.expected {
+			t.Errorf("%s:\n got %s\nwant %s", test.statement, actual, test.expected)
+		}
+	}
+}
+
+func TestParseQuotaStatementErrors(t *testing.T) {
+	tests := []struct {
+		statement string
+		error     string
+	}{
+		{"", "expected set, unset or zero, found end of statement"},
+		{"allow compute-core quotas in tenancy", "expected set, unset or zero, found \"allow\""},
+		{"set", "expected a name, found end of statement"},
+		{"set compute-core limit standard-e4-core-count to 10 in tenancy", "expected quota or quotas, found \"limit\""},
+		{"set compute-core quota to 10 in tenancy", "expected \"to\", found \"10\""},
+		{"set compute-core quota standard-e4-core-count in tenancy", "expected \"to\", found \"in\""},
+		{"set compute-core quota standard-e4-core-count to ten in tenancy", "expected a quota value, found \"ten\""},
+		{"zero compute-core quotas to 0 in tenancy", "expected \"in\", found \"to\""},
+		{"zero compute-core quotas", "expected \"in\", found end of statement"},
+		{"zero compute-core quotas in region us-ashburn-1", "expected tenancy or compartment, found \"region\""},
+		{"zero compute-core quotas in compartment", "expected a name, found end of statement"},
+		{"zero compute-core quotas in compartment 'Dev", "unterminated quoted name"},
+		{"zero compute-core quotas in tenancy where", "expected conditions after where"},
+		{"zero compute-core quotas in compartment Dev Prod", "expected end of statement, found \"Prod\""},
+	}
+
+	for _, test := range tests {
+		_, err := parseQuotaStatement(test.statement)
+		if err == nil {
+			t.Errorf("%s: expected an error", test.statement)
+			continue
+		}
+		if !strings.Contains(err.Error(), test.error) {
+			t.Errorf("%s: expected error containing %q, got %q", test.statement, test.error, err)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package oci
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"strings"
 
@@ -355,6 +356,41 @@ func getRegionFromEnvVar() string {
 	return getEnvSettingWithBlankDefault("region")
 }
 
+// getHomeRegion returns the name of the tenancy's home region
+func getHomeRegion(ctx context.Context, d *plugin.QueryData) (string, error) {
+	cacheKey := "getHomeRegion"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(string), nil
+	}
+
+	// Create Session
+	session, err := identityService(ctx, d)
+	if err != nil {
+		return "", err
+	}
+
+	request := identity.ListRegionSubscriptionsRequest{
+		TenancyId: types.String(session.TenancyID),
+		RequestMetadata: oci_common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.IdentityClient.ListRegionSubscriptions(ctx, request)
+	if err != nil {
+		return "", err
+	}
+
+	for _, subscription := range response.Items {
+		if types.BoolValue(subscription.IsHomeRegion) {
+			d.ConnectionManager.Cache.Set(cacheKey, *subscription.RegionName)
+			return *subscription.RegionName, nil
+		}
+	}
+
+	return "", fmt.Errorf("home region not found in the region subscriptions of tenancy %s", session.TenancyID)
+}
+
 func getCloudGuardConfiguration(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
 	cacheKey := "getCloudGuardConfiguration"
 	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
@@ -30,15 +30,28 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 		TableMap: map[string]*plugin.Table{
 			"oci_analytics_instance":                                       tableAnalyticsInstance(ctx),
 			"oci_apigateway_api":                                           tableApiGatewayApi(ctx),
+			"oci_apigateway_certificate":                                   tableApiGatewayCertificate(ctx),
+			"oci_apigateway_deployment":                                    tableApiGatewayDeployment(ctx),
+			"oci_apigateway_deployment_route":                              tableApiGatewayDeploymentRoute(ctx),
+			"oci_apigateway_gateway":                                       tableApiGatewayGateway(ctx),
 			"oci_autoscaling_auto_scaling_configuration":                   tableAutoScalingConfiguration(ctx),
 			"oci_bastion_bastion":                                          tableBastion(ctx),
 			"oci_bastion_session":                                          tableBastionSession(ctx),
 			"oci_budget_alert_rule":                                        tableBudgetAlertRule(ctx),
 			"oci_budget_budget":                                            tableBudget(ctx),
+			"oci_certificates_management_ca":                               tableCertificatesManagementCa(ctx),
+			"oci_certificates_management_ca_bundle":                        tableCertificatesManagementCaBundle(ctx),
+			"oci_certificates_management_certificate":                      tableCertificatesManagementCertificate(ctx),
+			"oci_certificates_management_certificate_version":              tableCertificatesManagementCertificateVersion(ctx),
 			"oci_cloud_guard_configuration":                                tableCloudGuardConfiguration(ctx),
 			"oci_cloud_guard_detector_recipe":                              tableCloudGuardDetectorRecipe(ctx),
+			"oci_cloud_guard_detector_rule":                                tableCloudGuardDetectorRule(ctx),
 			"oci_cloud_guard_managed_list":                                 tableCloudGuardManagedList(ctx),
+			"oci_cloud_guard_problem":                                      tableCloudGuardProblem(ctx),
+			"oci_cloud_guard_problem_history":                              tableCloudGuardProblemHistory(ctx),
+			"oci_cloud_guard_recommendation":                               tableCloudGuardRecommendation(ctx),
 			"oci_cloud_guard_responder_recipe":                             tableCloudGuardResponderRecipe(ctx),
+			"oci_cloud_guard_sighting":                                     tableCloudGuardSighting(ctx),
 			"oci_cloud_guard_target":                                       tableCloudGuardTarget(ctx),
 			"oci_containerengine_cluster":                                  tableOciContainerEngineCluster(ctx),
 			"oci_core_block_volume_replica":                                tableCoreBlockVolumeReplica(ctx),
@@ -64,9 +77,18 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"oci_core_instance_metric_cpu_utilization_hourly":              tableOciCoreInstanceMetricCpuUtilizationHourly(ctx),
 			"oci_core_internet_gateway":                                    tableCoreInternetGateway(ctx),
 			"oci_core_load_balancer":                                       tableCoreLoadBalancer(ctx),
+			"oci_core_load_balancer_backend":                               tableCoreLoadBalancerBackend(ctx),
+			"oci_core_load_balancer_backend_set":                           tableCoreLoadBalancerBackendSet(ctx),
+			"oci_core_load_balancer_certificate":                           tableCoreLoadBalancerCertificate(ctx),
+			"oci_core_load_balancer_health":                                tableCoreLoadBalancerHealth(ctx),
+			"oci_core_load_balancer_listener":                              tableCoreLoadBalancerListener(ctx),
 			"oci_core_local_peering_gateway":                               tableCoreLocalPeeringGateway(ctx),
 			"oci_core_nat_gateway":                                         tableCoreNatGateway(ctx),
 			"oci_core_network_load_balancer":                               tableCoreNetworkLoadBalancer(ctx),
+			"oci_core_network_load_balancer_backend":                       tableCoreNetworkLoadBalancerBackend(ctx),
+			"oci_core_network_load_balancer_backend_set":                   tableCoreNetworkLoadBalancerBackendSet(ctx),
+			"oci_core_network_load_balancer_health":                        tableCoreNetworkLoadBalancerHealth(ctx),
+			"oci_core_network_load_balancer_listener":                      tableCoreNetworkLoadBalancerListener(ctx),
 			"oci_core_network_security_group":                              tableCoreNetworkSecurityGroup(ctx),
 			"oci_core_public_ip":                                           tableCorePublicIP(ctx),
 			"oci_core_public_ip_pool":                                      tableCorePublicIPPool(ctx),
@@ -82,15 +104,24 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"oci_core_volume_backup_policy":                                tableCoreVolumeBackupPolicy(ctx),
 			"oci_core_volume_default_backup_policy":                        tableCoreVolumeDefaultBackupPolicy(ctx),
 			"oci_core_volume_group":                                        tableCoreVolumeGroup(ctx),
+			"oci_cost_report_line_item":                                    tableCostReportLineItem(ctx),
+			"oci_database_autonomous_container_database":                   tableOciDatabaseAutonomousContainerDatabase(ctx),
 			"oci_database_autonomous_database":                             tableOciDatabaseAutonomousDatabase(ctx),
+			"oci_database_autonomous_database_backup":                      tableOciDatabaseAutonomousDatabaseBackup(ctx),
 			"oci_database_autonomous_db_metric_cpu_utilization":            tableOciDatabaseAutonomousDatabaseMetricCpuUtilization(ctx),
 			"oci_database_autonomous_db_metric_cpu_utilization_daily":      tableOciDatabaseAutonomousDatabaseMetricCpuUtilizationDaily(ctx),
 			"oci_database_autonomous_db_metric_cpu_utilization_hourly":     tableOciDatabaseAutonomousDatabaseMetricCpuUtilizationHourly(ctx),
 			"oci_database_autonomous_db_metric_storage_utilization":        tableOciDatabaseAutonomousDatabaseMetricStorageUtilization(ctx),
 			"oci_database_autonomous_db_metric_storage_utilization_daily":  tableOciDatabaseAutonomousDatabaseMetricStorageUtilizationDaily(ctx),
 			"oci_database_autonomous_db_metric_storage_utilization_hourly": tableOciDatabaseAutonomousDatabaseMetricStorageUtilizationHourly(ctx),
+			"oci_database_autonomous_exadata_infrastructure":               tableOciDatabaseAutonomousExadataInfrastructure(ctx),
+			"oci_database_backup":                                          tableOciDatabaseBackup(ctx),
+			"oci_database_cloud_exadata_infrastructure":                    tableOciDatabaseCloudExadataInfrastructure(ctx),
+			"oci_database_cloud_vm_cluster":                                tableOciDatabaseCloudVmCluster(ctx),
+			"oci_database_data_guard_association":                          tableOciDatabaseDataGuardAssociation(ctx),
 			"oci_database_db":                                              tableOciDatabase(ctx),
 			"oci_database_db_home":                                         tableOciDatabaseDBHome(ctx),
+			"oci_database_db_node":                                         tableOciDatabaseDbNode(ctx),
 			"oci_database_db_system":                                       tableOciDatabaseDBSystem(ctx),
 			"oci_database_pluggable_database":                              tableOciPluggableDatabase(ctx),
 			"oci_database_software_image":                                  tableOciDatabaseSoftwareImage(ctx),
@@ -103,25 +134,52 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"oci_file_storage_snapshot":                                    tableFileStorageSnapshot(ctx),
 			"oci_functions_application":                                    tableFunctionsApplication(ctx),
 			"oci_functions_function":                                       tableFunctionsFunction(ctx),
+			"oci_functions_pbf_listing":                                    tableFunctionsPbfListing(ctx),
 			"oci_identity_api_key":                                         tableIdentityApiKey(ctx),
 			"oci_identity_auth_token":                                      tableIdentityAuthToken(ctx),
 			"oci_identity_authentication_policy":                           tableIdentityAuthenticationPolicy(ctx),
 			"oci_identity_availability_domain":                             tableIdentityAvailabilityDomain(ctx),
 			"oci_identity_compartment":                                     tableIdentityCompartment(ctx),
 			"oci_identity_customer_secret_key":                             tableIdentityCustomerSecretKey(ctx),
+			"oci_identity_db_credential":                                   tableIdentityDbCredential(ctx),
+			"oci_identity_domain":                                          tableIdentityDomain(ctx),
+			"oci_identity_domain_app":                                      tableIdentityDomainApp(ctx),
+			"oci_identity_domain_group":                                    tableIdentityDomainGroup(ctx),
+			"oci_identity_domain_group_membership":                         tableIdentityDomainGroupMembership(ctx),
+			"oci_identity_domain_mfa_enrollment":                           tableIdentityDomainMfaEnrollment(ctx),
+			"oci_identity_domain_password_policy":                          tableIdentityDomainPasswordPolicy(ctx),
+			"oci_identity_domain_sign_on_policy":                           tableIdentityDomainSignOnPolicy(ctx),
+			"oci_identity_domain_user":                                     tableIdentityDomainUser(ctx),
 			"oci_identity_dynamic_group":                                   tableIdentityDynamicGroup(ctx),
+			"oci_identity_dynamic_group_member":                            tableIdentityDynamicGroupMember(ctx),
+			"oci_identity_effective_permission":                            tableIdentityEffectivePermission(ctx),
 			"oci_identity_group":                                           tableIdentityGroup(ctx),
+			"oci_identity_identity_provider":                               tableIdentityIdentityProvider(ctx),
+			"oci_identity_idp_group_mapping":                               tableIdentityIdpGroupMapping(ctx),
 			"oci_identity_network_source":                                  tableIdentityNetworkSource(ctx),
+			"oci_identity_oauth2_client_credential":                        tableIdentityOAuth2ClientCredential(ctx),
 			"oci_identity_policy":                                          tableIdentityPolicy(ctx),
+			"oci_identity_policy_statement":                                tableIdentityPolicyStatement(ctx),
+			"oci_identity_smtp_credential":                                 tableIdentitySmtpCredential(ctx),
+			"oci_identity_tag":                                             tableIdentityTag(ctx),
+			"oci_identity_tag_compliance":                                  tableIdentityTagCompliance(ctx),
 			"oci_identity_tag_default":                                     tableIdentityTagDefault(ctx),
 			"oci_identity_tag_namespace":                                   tableIdentityTagNamespace(ctx),
 			"oci_identity_tenancy":                                         tableIdentityTenancy(ctx),
 			"oci_identity_user":                                            tableIdentityUser(ctx),
+			"oci_identity_user_group_membership":                           tableIdentityUserGroupMembership(ctx),
 			"oci_kms_key":                                                  tableKmsKey(ctx),
 			"oci_kms_key_version":                                          tableKmsKeyVersion(ctx),
 			"oci_kms_vault":                                                tableKmsVault(ctx),
+			"oci_kms_vault_replica":                                        tableKmsVaultReplica(ctx),
+			"oci_kms_vault_usage":                                          tableKmsVaultUsage(ctx),
+			"oci_limits_definition":                                        tableLimitsDefinition(ctx),
+			"oci_limits_quota":                                             tableLimitsQuota(ctx),
+			"oci_limits_resource_availability":                             tableLimitsResourceAvailability(ctx),
+			"oci_limits_value":                                             tableLimitsValue(ctx),
 			"oci_logging_log":                                              tableLoggingLog(ctx),
 			"oci_logging_log_group":                                        tableLoggingLogGroup(ctx),
+			"oci_logging_search_result":                                    tableLoggingSearchResult(ctx),
 			"oci_mysql_backup":                                             tableMySQLBackup(ctx),
 			"oci_mysql_channel":                                            tableMySQLChannel(ctx),
 			"oci_mysql_configuration":                                      tableMySQLConfiguration(ctx),
@@ -150,12 +208,32 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"oci_objectstorage_object":                                     tableObjectStorageObject(ctx),
 			"oci_ons_notification_topic":                                   tableOnsNotificationTopic(ctx),
 			"oci_ons_subscription":                                         tableOnsSubscription(ctx),
+			"oci_queue_message":                                            tableQueueMessage(ctx),
 			"oci_queue_queue":                                              tableQueueQueue(ctx),
 			"oci_region":                                                   tableIdentityRegion(ctx),
 			"oci_resource_search":                                          tableResourceSearch(ctx),
+			"oci_resourcemanager_configuration_source_provider":            tableResourceManagerConfigurationSourceProvider(ctx),
+			"oci_resourcemanager_job":                                      tableResourceManagerJob(ctx),
+			"oci_resourcemanager_job_log":                                  tableResourceManagerJobLog(ctx),
 			"oci_resourcemanager_stack":                                    tableOciResourceManagerStack(ctx),
+			"oci_resourcemanager_stack_resource_drift":                     tableResourceManagerStackResourceDrift(ctx),
+			"oci_resourcemanager_stack_tf_resource":                        tableResourceManagerStackTfResource(ctx),
+			"oci_security_zone":                                            tableSecurityZone(ctx),
+			"oci_security_zone_policy":                                     tableSecurityZonePolicy(ctx),
+			"oci_security_zone_recipe":                                     tableSecurityZoneRecipe(ctx),
+			"oci_streaming_connect_harness":                                tableStreamingConnectHarness(ctx),
+			"oci_streaming_partition":                                      tableStreamingPartition(ctx),
 			"oci_streaming_stream":                                         tableOciStreamingStream(ctx),
+			"oci_streaming_stream_pool":                                    tableStreamingStreamPool(ctx),
+			"oci_usage_forecast":                                           tableUsageForecast(ctx),
+			"oci_usage_summary":                                            tableUsageSummary(ctx),
 			"oci_vault_secret":                                             tableVaultSecret(ctx),
+			"oci_vault_secret_bundle":                                      tableVaultSecretBundle(ctx),
+			"oci_vault_secret_version":                                     tableVaultSecretVersion(ctx),
+			"oci_vulnerability_scanning_container_scan_result":             tableVulnerabilityScanningContainerScanResult(ctx),
+			"oci_vulnerability_scanning_host_agent_scan_result":            tableVulnerabilityScanningHostAgentScanResult(ctx),
+			"oci_vulnerability_scanning_host_scan_recipe":                  tableVulnerabilityScanningHostScanRecipe(ctx),
+			"oci_vulnerability_scanning_host_scan_target":                  tableVulnerabilityScanningHostScanTarget(ctx),
 		},
 	}
 	return p
@@ -19,6 +19,8 @@ import (
 	"github.com/oracle/oci-go-sdk/v65/autoscaling"
 	"github.com/oracle/oci-go-sdk/v65/bastion"
 	"github.com/oracle/oci-go-sdk/v65/budget"
+	"github.com/oracle/oci-go-sdk/v65/certificates"
+	"github.com/oracle/oci-go-sdk/v65/certificatesmanagement"
 	"github.com/oracle/oci-go-sdk/v65/cloudguard"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_common_auth "github.com/oracle/oci-go-sdk/v65/common/auth"
@@ -31,8 +33,10 @@ import (
 	"github.com/oracle/oci-go-sdk/v65/functions"
 	"github.com/oracle/oci-go-sdk/v65/identity"
 	"github.com/oracle/oci-go-sdk/v65/keymanagement"
+	"github.com/oracle/oci-go-sdk/v65/limits"
 	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
 	"github.com/oracle/oci-go-sdk/v65/logging"
+	"github.com/oracle/oci-go-sdk/v65/loggingsearch"
 	"github.com/oracle/oci-go-sdk/v65/monitoring"
 	"github.com/oracle/oci-go-sdk/v65/mysql"
 	"github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
@@ -42,8 +46,11 @@ import (
 	"github.com/oracle/oci-go-sdk/v65/queue"
 	"github.com/oracle/oci-go-sdk/v65/resourcemanager"
 	"github.com/oracle/oci-go-sdk/v65/resourcesearch"
+	"github.com/oracle/oci-go-sdk/v65/secrets"
 	"github.com/oracle/oci-go-sdk/v65/streaming"
+	"github.com/oracle/oci-go-sdk/v65/usageapi"
 	"github.com/oracle/oci-go-sdk/v65/vault"
+	"github.com/oracle/oci-go-sdk/v65/vulnerabilityscanning"
 	"github.com/turbot/go-kit/types"
 	"github.com/turbot/steampipe-plugin-sdk/v4/connection"
 	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
@@ -53,11 +60,15 @@ type session struct {
 	TenancyID                      string
 	AnalyticsClient                analytics.AnalyticsClient
 	ApiGatewayClient               apigateway.ApiGatewayClient
+	ApiGatewayDeploymentClient     apigateway.DeploymentClient
+	ApiGatewayGatewayClient        apigateway.GatewayClient
 	AuditClient                    audit.AuditClient
 	AutoScalingClient              autoscaling.AutoScalingClient
 	BastionClient                  bastion.BastionClient
 	BlockstorageClient             core.BlockstorageClient
 	BudgetClient                   budget.BudgetClient
+	CertificatesClient             certificates.CertificatesClient
+	CertificatesManagementClient   certificatesmanagement.CertificatesManagementClient
 	CloudGuardClient               cloudguard.CloudGuardClient
 	ComputeClient                  core.ComputeClient
 	ComputeManagementClient        core.ComputeManagementClient
@@ -68,8 +79,11 @@ type session struct {
 	FileStorageClient              filestorage.FileStorageClient
 	FunctionsManagementClient      functions.FunctionsManagementClient
 	IdentityClient                 identity.IdentityClient
+	IdentityDomainClient           oci_common.BaseClient
 	KmsManagementClient            keymanagement.KmsManagementClient
 	KmsVaultClient                 keymanagement.KmsVaultClient
+	LimitsClient                   limits.LimitsClient
+	LogSearchClient                loggingsearch.LogSearchClient
 	LoggingManagementClient        logging.LoggingManagementClient
 	LoadBalancerClient             loadbalancer.LoadBalancerClient
 	MonitoringClient               monitoring.MonitoringClient
@@ -83,11 +97,17 @@ type session struct {
 	NotificationDataPlaneClient    ons.NotificationDataPlaneClient
 	ObjectStorageClient            objectstorage.ObjectStorageClient
 	QueueAdminClient               queue.QueueAdminClient
+	QueueClient                    queue.QueueClient
+	QuotasClient                   limits.QuotasClient
 	ResourceSearchClient           resourcesearch.ResourceSearchClient
 	ResourceManagerClient          resourcemanager.ResourceManagerClient
+	SecretsClient                  secrets.SecretsClient
 	StreamAdminClient              streaming.StreamAdminClient
+	StreamClient                   streaming.StreamClient
+	UsageapiClient                 usageapi.UsageapiClient
 	VaultClient                    vault.VaultsClient
 	VirtualNetworkClient           core.VirtualNetworkClient
+	VulnerabilityScanningClient    vulnerabilityscanning.VulnerabilityScanningClient
 }
 
 // apiGatewayService returns the service client for OCI ApiGateway service
@@ -116,6 +136,18 @@ func apiGatewayService(ctx context.Context, d *plugin.QueryData, region string)
 		return nil, err
 	}
 
+	gatewayClient, err := apigateway.NewGatewayClientWithConfigurationProvider(provider)
+	if err != nil {
+		logger.Error("apiGatewayService", "error_NewGatewayClientWithConfigurationProvider", err)
+		return nil, err
+	}
+
+	deploymentClient, err := apigateway.NewDeploymentClientWithConfigurationProvider(provider)
+	if err != nil {
+		logger.Error("apiGatewayService", "error_NewDeploymentClientWithConfigurationProvider", err)
+		return nil, err
+	}
+
 	// get tenant ocid from provider
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
@@ -124,8 +156,10 @@ func apiGatewayService(ctx context.Context, d *plugin.QueryData, region string)
 	}
 
 	sess := &session{
-		TenancyID:        tenantId,
-		ApiGatewayClient: client,
+		TenancyID:                  tenantId,
+		ApiGatewayClient:           client,
+		ApiGatewayDeploymentClient: deploymentClient,
+		ApiGatewayGatewayClient:    gatewayClient,
 	}
 
 	// save session in cache
@@ -252,6 +286,57 @@ func identityService(ctx context.Context, d *plugin.QueryData) (*session, error)
 	return sess, nil
 }
 
+// identityDomainService returns a signing client for the SCIM API of the OCI Identity Domain served at domainUrl
+func identityDomainService(ctx context.Context, d *plugin.QueryData, domainUrl string) (*session, error) {
+	logger := plugin.Logger(ctx)
+
+	// have we already created and cached the service?
+	serviceCacheKey := fmt.Sprintf("identitydomain-%s", domainUrl)
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*session), nil
+	}
+
+	// get oci config info
+	ociConfig := GetConfig(d.Connection)
+
+	provider, err := getProvider(ctx, d.ConnectionManager, "", ociConfig)
+	if err != nil {
+		logger.Error("identityDomainService", "error_getProvider", err)
+		return nil, err
+	}
+
+	// only sign requests for identity domain endpoints of the realm
+	region, _ := provider.Region()
+	if err := validateIdentityDomainUrl(domainUrl, identityDomainRealmDomain(region)); err != nil {
+		logger.Error("identityDomainService", "invalid_domain_url", err)
+		return nil, err
+	}
+
+	// the SDK has no Identity Domains client, so sign raw SCIM requests with a base client
+	client, err := oci_common.NewClientWithConfig(provider)
+	if err != nil {
+		logger.Error("identityDomainService", "error_NewClientWithConfig", err)
+		return nil, err
+	}
+	client.Host = strings.TrimSuffix(domainUrl, "/")
+
+	tenantId, err := provider.TenancyOCID()
+	if err != nil {
+		logger.Error("identityDomainService", "error_TenancyOCID", err)
+		return nil, err
+	}
+
+	sess := &session{
+		TenancyID:            tenantId,
+		IdentityDomainClient: client,
+	}
+
+	// save session in cache
+	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
+
+	return sess, nil
+}
+
 // identityServiceRegional returns the service client for OCI Identity Regional Service
 func identityServiceRegional(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
@@ -1339,6 +1424,48 @@ func queueService(ctx context.Context, d *plugin.QueryData, region string) (*ses
 }
 
 
+// queueMessageService returns the service client for the OCI Queue data plane
+// at the messages endpoint of a queue
+func queueMessageService(ctx context.Context, d *plugin.QueryData, region string, endpoint string) (*session, error) {
+	logger := plugin.Logger(ctx)
+
+	// Cache the connection at messages endpoint level
+	serviceCacheKey := fmt.Sprintf("queuemessage-%s-%s", region, endpoint)
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*session), nil
+	}
+
+	// get oci config info
+	ociConfig := GetConfig(d.Connection)
+
+	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	if err != nil {
+		logger.Error("queueMessageService", "getProvider.Error", err)
+		return nil, err
+	}
+
+	client, err := queue.NewQueueClientWithConfigurationProvider(provider)
+	if err != nil {
+		return nil, err
+	}
+	client.Host = endpoint
+
+	tenantId, err := provider.TenancyOCID()
+	if err != nil {
+		return nil, err
+	}
+
+	sess := &session{
+		TenancyID:   tenantId,
+		QueueClient: client,
+	}
+
+	// save session in cache
+	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
+
+	return sess, nil
+}
+
 // resourceSearchService returns the service client for OCI Resource Search Service
 func resourceSearchService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
@@ -1452,6 +1579,47 @@ func streamAdminService(ctx context.Context, d *plugin.QueryData, region string)
 	return sess, nil
 }
 
+// streamService returns the service client for the OCI Streaming data plane
+// at the messages endpoint of a stream
+func streamService(ctx context.Context, d *plugin.QueryData, region string, endpoint string) (*session, error) {
+	logger := plugin.Logger(ctx)
+
+	// Cache the connection at messages endpoint level
+	serviceCacheKey := fmt.Sprintf("stream-%s-%s", region, endpoint)
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*session), nil
+	}
+
+	// get oci config info
+	ociConfig := GetConfig(d.Connection)
+
+	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	if err != nil {
+		logger.Error("streamService", "getProvider.Error", err)
+		return nil, err
+	}
+
+	client, err := streaming.NewStreamClientWithConfigurationProvider(provider, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantId, err := provider.TenancyOCID()
+	if err != nil {
+		return nil, err
+	}
+
+	sess := &session{
+		TenancyID:    tenantId,
+		StreamClient: client,
+	}
+
+	// save session in cache
+	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
+
+	return sess, nil
+}
+
 // vaultService returns the service client for OCI Vault Service
 func vaultService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
@@ -1574,6 +1742,264 @@ func bastionService(ctx context.Context, d *plugin.QueryData, region string) (*s
 	return sess, nil
 }
 
+// limitsService returns the service client for OCI Limits service
+func limitsService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
+	logger := plugin.Logger(ctx)
+
+	// have we already created and cached the service?
+	serviceCacheKey := fmt.Sprintf("limits-%s", region)
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*session), nil
+	}
+
+	// get oci config info from steampipe connection
+	ociConfig := GetConfig(d.Connection)
+
+	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	if err != nil {
+		logger.Error("limitsService", "getProvider.Error", err)
+		return nil, err
+	}
+
+	limitsClient, err := limits.NewLimitsClientWithConfigurationProvider(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	quotasClient, err := limits.NewQuotasClientWithConfigurationProvider(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	// get tenant ocid from provider
+	tenantId, err := provider.TenancyOCID()
+	if err != nil {
+		return nil, err
+	}
+
+	sess := &session{
+		TenancyID:    tenantId,
+		LimitsClient: limitsClient,
+		QuotasClient: quotasClient,
+	}
+
+	// save session in cache
+	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
+
+	return sess, nil
+}
+
+// usageApiService returns the service client for OCI Usage API
+func usageApiService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
+	logger := plugin.Logger(ctx)
+
+	// have we already created and cached the service?
+	serviceCacheKey := fmt.Sprintf("usageapi-%s", region)
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*session), nil
+	}
+
+	// get oci config info from steampipe connection
+	ociConfig := GetConfig(d.Connection)
+
+	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	if err != nil {
+		logger.Error("usageApiService", "getProvider.Error", err)
+		return nil, err
+	}
+
+	client, err := usageapi.NewUsageapiClientWithConfigurationProvider(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	// get tenant ocid from provider
+	tenantId, err := provider.TenancyOCID()
+	if err != nil {
+		return nil, err
+	}
+
+	sess := &session{
+		TenancyID:      tenantId,
+		UsageapiClient: client,
+	}
+
+	// save session in cache
+	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
+
+	return sess, nil
+}
+
+// secretsService returns the service client for OCI Vault Secrets retrieval
+func secretsService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
+	logger := plugin.Logger(ctx)
+
+	// have we already created and cached the service?
+	serviceCacheKey := fmt.Sprintf("secrets-%s", region)
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*session), nil
+	}
+
+	// get oci config info from steampipe connection
+	ociConfig := GetConfig(d.Connection)
+
+	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	if err != nil {
+		logger.Error("secretsService", "getProvider.Error", err)
+		return nil, err
+	}
+
+	client, err := secrets.NewSecretsClientWithConfigurationProvider(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	// get tenant ocid from provider
+	tenantId, err := provider.TenancyOCID()
+	if err != nil {
+		return nil, err
+	}
+
+	sess := &session{
+		TenancyID:     tenantId,
+		SecretsClient: client,
+	}
+
+	// save session in cache
+	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
+
+	return sess, nil
+}
+
+// certificatesManagementService returns the service client for OCI Certificates Management
+func certificatesManagementService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
+	logger := plugin.Logger(ctx)
+
+	// have we already created and cached the service?
+	serviceCacheKey := fmt.Sprintf("certificatesmanagement-%s", region)
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*session), nil
+	}
+
+	// get oci config info from steampipe connection
+	ociConfig := GetConfig(d.Connection)
+
+	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	if err != nil {
+		logger.Error("certificatesManagementService", "getProvider.Error", err)
+		return nil, err
+	}
+
+	certificatesManagementClient, err := certificatesmanagement.NewCertificatesManagementClientWithConfigurationProvider(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	certificatesClient, err := certificates.NewCertificatesClientWithConfigurationProvider(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	// get tenant ocid from provider
+	tenantId, err := provider.TenancyOCID()
+	if err != nil {
+		return nil, err
+	}
+
+	sess := &session{
+		TenancyID:                    tenantId,
+		CertificatesManagementClient: certificatesManagementClient,
+		CertificatesClient:           certificatesClient,
+	}
+
+	// save session in cache
+	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
+
+	return sess, nil
+}
+
+// loggingSearchService returns the service client for OCI Logging Search
+func loggingSearchService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
+	logger := plugin.Logger(ctx)
+
+	// have we already created and cached the service?
+	serviceCacheKey := fmt.Sprintf("loggingsearch-%s", region)
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*session), nil
+	}
+
+	// get oci config info from steampipe connection
+	ociConfig := GetConfig(d.Connection)
+
+	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	if err != nil {
+		logger.Error("loggingSearchService", "getProvider.Error", err)
+		return nil, err
+	}
+
+	client, err := loggingsearch.NewLogSearchClientWithConfigurationProvider(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	// get tenant ocid from provider
+	tenantId, err := provider.TenancyOCID()
+	if err != nil {
+		return nil, err
+	}
+
+	sess := &session{
+		TenancyID:       tenantId,
+		LogSearchClient: client,
+	}
+
+	// save session in cache
+	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
+
+	return sess, nil
+}
+
+// vulnerabilityScanningService returns the service client for OCI Vulnerability Scanning service
+func vulnerabilityScanningService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
+	logger := plugin.Logger(ctx)
+
+	// have we already created and cached the service?
+	serviceCacheKey := fmt.Sprintf("vulnerabilityscanning-%s", region)
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*session), nil
+	}
+
+	// get oci config info from steampipe connection
+	ociConfig := GetConfig(d.Connection)
+
+	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	if err != nil {
+		logger.Error("vulnerabilityScanningService", "getProvider.Error", err)
+		return nil, err
+	}
+
+	client, err := vulnerabilityscanning.NewVulnerabilityScanningClientWithConfigurationProvider(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	// get tenant ocid from provider
+	tenantId, err := provider.TenancyOCID()
+	if err != nil {
+		return nil, err
+	}
+
+	sess := &session{
+		TenancyID:                   tenantId,
+		VulnerabilityScanningClient: client,
+	}
+
+	// save session in cache
+	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
+
+	return sess, nil
+}
+
 // get the configuration provider for the OCI plugin connection to intract with API's
 func getProvider(_ context.Context, d *connection.Manager, region string, config ociConfig) (oci_common.ConfigurationProvider, error) {
 
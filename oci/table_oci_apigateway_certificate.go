@@ -0,0 +1,316 @@
+package oci
+
+import (
+	"context"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/apigateway"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableApiGatewayCertificate(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_apigateway_certificate",
+		Description:      "OCI API Gateway Certificate",
+		DefaultTransform: transform.FromCamel(),
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getApiGatewayCertificate,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listApiGatewayCertificates,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "display_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "lifecycle_state",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "display_name",
+				Description: "A user-friendly name of the certificate.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The OCID of the certificate.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_state",
+				Description: "The current state of the certificate.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_details",
+				Description: "A message describing the current state in more detail.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_not_valid_after",
+				Description: "The date and time after which the certificate is no longer valid.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeNotValidAfter.Time"),
+			},
+			{
+				Name:        "time_created",
+				Description: "The date and time the certificate was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeCreated.Time"),
+			},
+			{
+				Name:        "time_updated",
+				Description: "The date and time the certificate was updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeUpdated.Time"),
+			},
+			{
+				Name:        "certificate",
+				Description: "The PEM-encoded public certificate.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getApiGatewayCertificate,
+			},
+			{
+				Name:        "intermediate_certificates",
+				Description: "The PEM-encoded intermediate certificates of the certificate chain.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getApiGatewayCertificate,
+			},
+
+			// json fields
+			{
+				Name:        "subject_names",
+				Description: "The entity to be secured by the certificate and additional host names.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// tags
+			{
+				Name:        "defined_tags",
+				Description: ColumnDescriptionDefinedTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "freeform_tags",
+				Description: ColumnDescriptionFreefromTags,
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(apiGatewayCertificateTags),
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id").Transform(ociRegionName),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listApiGatewayCertificates(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Trace("listApiGatewayCertificates", "Compartment", compartment, "OCI_REGION", region)
+
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given compartment_id doesn't match
+	if equalQuals["compartment_id"] != nil && compartment != equalQuals["compartment_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := apiGatewayService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_apigateway_certificate.listApiGatewayCertificates", "connection_error", err)
+		return nil, err
+	}
+
+	// Build request parameters
+	request := buildApiGatewayCertificateFilters(equalQuals)
+	request.CompartmentId = types.String(compartment)
+	request.Limit = types.Int(1000)
+	request.RequestMetadata = common.RequestMetadata{
+		RetryPolicy: getDefaultRetryPolicy(d.Connection),
+	}
+
+	// Check for limit
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < int64(*request.Limit) {
+			request.Limit = types.Int(int(*limit))
+		}
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.ApiGatewayClient.ListCertificates(ctx, request)
+		if err != nil {
+			logger.Error("oci_apigateway_certificate.listApiGatewayCertificates", "api_error", err)
+			return nil, err
+		}
+
+		for _, certificate := range response.Items {
+			d.StreamListItem(ctx, certificate)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTION
+
+func getApiGatewayCertificate(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Trace("getApiGatewayCertificate", "Compartment", compartment, "OCI_REGION", region)
+
+	var id string
+	if h.Item != nil {
+		id = *h.Item.(apigateway.CertificateSummary).Id
+	} else {
+		id = d.KeyColumnQuals["id"].GetStringValue()
+		// Restrict the api call to only root compartment/ per region
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+			return nil, nil
+		}
+	}
+
+	// handle empty id in get call
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := apiGatewayService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_apigateway_certificate.getApiGatewayCertificate", "connection_error", err)
+		return nil, err
+	}
+
+	request := apigateway.GetCertificateRequest{
+		CertificateId: types.String(id),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.ApiGatewayClient.GetCertificate(ctx, request)
+	if err != nil {
+		logger.Error("oci_apigateway_certificate.getApiGatewayCertificate", "api_error", err)
+		return nil, err
+	}
+
+	return response.Certificate, nil
+}
+
+//// TRANSFORM FUNCTION
+
+// Priority order for tags
+// 1. Defined Tags
+// 2. Free-form tags
+func apiGatewayCertificateTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	var freeformTags map[string]string
+	var definedTags map[string]map[string]interface{}
+
+	switch certificate := d.HydrateItem.(type) {
+	case apigateway.CertificateSummary:
+		freeformTags = certificate.FreeformTags
+		definedTags = certificate.DefinedTags
+	case apigateway.Certificate:
+		freeformTags = certificate.FreeformTags
+		definedTags = certificate.DefinedTags
+	}
+
+	var tags map[string]interface{}
+
+	if freeformTags != nil {
+		tags = map[string]interface{}{}
+		for k, v := range freeformTags {
+			tags[k] = v
+		}
+	}
+
+	if definedTags != nil {
+		if tags == nil {
+			tags = map[string]interface{}{}
+		}
+		for _, v := range definedTags {
+			for key, value := range v {
+				tags[key] = value
+			}
+		}
+	}
+
+	return tags, nil
+}
+
+// Build additional filters
+func buildApiGatewayCertificateFilters(equalQuals plugin.KeyColumnEqualsQualMap) apigateway.ListCertificatesRequest {
+	request := apigateway.ListCertificatesRequest{}
+
+	if equalQuals["display_name"] != nil {
+		request.DisplayName = types.String(equalQuals["display_name"].GetStringValue())
+	}
+	if equalQuals["lifecycle_state"] != nil {
+		request.LifecycleState = apigateway.CertificateLifecycleStateEnum(equalQuals["lifecycle_state"].GetStringValue())
+	}
+
+	return request
+}
@@ -0,0 +1,376 @@
+package oci
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/apigateway"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableApiGatewayDeploymentRoute(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_apigateway_deployment_route",
+		Description:      "OCI API Gateway Deployment Route",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			ParentHydrate: listApiGatewayDeployments,
+			Hydrate:       listApiGatewayDeploymentRoutes,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "gateway_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "deployment_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "path",
+				Description: "The path pattern of the route, relative to the deployment path prefix.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "method",
+				Description: "The HTTP method allowed on the route. Routes that don't list any methods are returned once with a null method.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "endpoint",
+				Description: "The URL of the route on the gateway.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "backend_type",
+				Description: "The type of the backend, e.g. HTTP_BACKEND, ORACLE_FUNCTIONS_BACKEND or STOCK_RESPONSE_BACKEND.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "backend_url",
+				Description: "The URL of the backend, for HTTP backends.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "backend_function_id",
+				Description: "The OCID of the function, for Oracle Functions backends.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "authentication_type",
+				Description: "The type of the authentication policy of the deployment, e.g. JWT_AUTHENTICATION, TOKEN_AUTHENTICATION, CUSTOM_AUTHENTICATION or DYNAMIC_AUTHENTICATION, or UNKNOWN if the type is not reported. Null if the deployment doesn't authenticate requests.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "authorization_type",
+				Description: "The type of the authorization policy of the route: ANONYMOUS, ANY_OF or AUTHENTICATION_ONLY. Null if the deployment doesn't authenticate requests.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_anonymous_access_allowed",
+				Description: "True if the authentication policy of the deployment lets unauthenticated requests through to routes with ANONYMOUS authorization. For dynamic authentication, true if any of the authentication servers does. Null if the deployment doesn't authenticate requests.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "is_authentication_required",
+				Description: "True if requests to the route must be authenticated, i.e. the deployment has an authentication policy and the route doesn't both use ANONYMOUS authorization and have anonymous access allowed by the deployment.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "deployment_id",
+				Description: "The OCID of the deployment the route belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "deployment_display_name",
+				Description: "The display name of the deployment the route belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "path_prefix",
+				Description: "The path prefix of the deployment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "gateway_id",
+				Description: "The OCID of the gateway the route is served by.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// json fields
+			{
+				Name:        "backend",
+				Description: "The backend configuration of the route.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "authentication",
+				Description: "The authentication policy of the deployment.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "authorization",
+				Description: "The authorization policy of the route.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "cors",
+				Description: "The CORS policy in effect for the route, either its own or the one of the deployment.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "request_policies",
+				Description: "The request policies of the route.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "response_policies",
+				Description: "The response policies of the route.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "logging_policies",
+				Description: "The logging policies of the route.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(apiGatewayDeploymentRouteTitle),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DeploymentId").Transform(ociRegionName),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+// apiGatewayDeploymentRouteInfo is a single path and method of a deployment,
+// with the policies that apply to it
+type apiGatewayDeploymentRouteInfo struct {
+	Path                     *string
+	Method                   *string
+	Endpoint                 *string
+	BackendType              *string
+	BackendUrl               *string
+	BackendFunctionId        *string
+	Backend                  apigateway.ApiSpecificationRouteBackend
+	AuthenticationType       *string
+	Authentication           interface{}
+	AuthorizationType        *string
+	Authorization            apigateway.RouteAuthorizationPolicy
+	IsAnonymousAccessAllowed *bool
+	IsAuthenticationRequired bool
+	Cors                     *apigateway.CorsPolicy
+	RequestPolicies          *apigateway.ApiSpecificationRouteRequestPolicies
+	ResponsePolicies         *apigateway.ApiSpecificationRouteResponsePolicies
+	LoggingPolicies          *apigateway.ApiSpecificationLoggingPolicies
+	DeploymentId             *string
+	DeploymentDisplayName    *string
+	PathPrefix               *string
+	GatewayId                *string
+	CompartmentId            *string
+}
+
+//// LIST FUNCTION
+
+func listApiGatewayDeploymentRoutes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	summary := h.Item.(apigateway.DeploymentSummary)
+
+	// Return nil, if given deployment_id doesn't match
+	if d.KeyColumnQuals["deployment_id"] != nil && types.SafeString(summary.Id) != d.KeyColumnQuals["deployment_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// The routes are only returned by the get call
+	deployment, err := getApiGatewayDeployment(ctx, d, h)
+	if err != nil {
+		if ociErr, ok := err.(common.ServiceError); ok && ociErr.GetHTTPStatusCode() == 404 {
+			return nil, nil
+		}
+		logger.Error("oci_apigateway_deployment_route.listApiGatewayDeploymentRoutes", "api_error", err)
+		return nil, err
+	}
+	if deployment == nil {
+		return nil, nil
+	}
+
+	for _, route := range apiGatewayDeploymentRoutes(deployment.(apigateway.Deployment)) {
+		d.StreamLeafListItem(ctx, route)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// apiGatewayDeploymentRoutes flattens the routes of a deployment into one row
+// per path and method, resolving the policies inherited from the deployment
+func apiGatewayDeploymentRoutes(deployment apigateway.Deployment) []apiGatewayDeploymentRouteInfo {
+	if deployment.Specification == nil {
+		return nil
+	}
+
+	// Authentication is configured for the whole deployment
+	var authenticationType *string
+	var authentication interface{}
+	var isAnonymousAccessAllowed *bool
+	var deploymentCors *apigateway.CorsPolicy
+	if policies := deployment.Specification.RequestPolicies; policies != nil {
+		deploymentCors = policies.Cors
+		if policies.Authentication != nil {
+			authentication = policies.Authentication
+			authenticationType = apiGatewayAuthenticationType(policies.Authentication)
+			isAnonymousAccessAllowed = types.Bool(types.BoolValue(policies.Authentication.GetIsAnonymousAccessAllowed()))
+		} else if policies.DynamicAuthentication != nil {
+			authentication = policies.DynamicAuthentication
+			authenticationType = types.String("DYNAMIC_AUTHENTICATION")
+			isAnonymousAccessAllowed = types.Bool(false)
+			for _, server := range policies.DynamicAuthentication.AuthenticationServers {
+				if server.AuthenticationServerDetail != nil && types.BoolValue(server.AuthenticationServerDetail.GetIsAnonymousAccessAllowed()) {
+					isAnonymousAccessAllowed = types.Bool(true)
+				}
+			}
+		}
+	}
+
+	var routes []apiGatewayDeploymentRouteInfo
+	for _, route := range deployment.Specification.Routes {
+		info := apiGatewayDeploymentRouteInfo{
+			Path:                     route.Path,
+			Endpoint:                 types.String(strings.TrimSuffix(types.SafeString(deployment.Endpoint), "/") + types.SafeString(route.Path)),
+			Backend:                  route.Backend,
+			AuthenticationType:       authenticationType,
+			Authentication:           authentication,
+			IsAnonymousAccessAllowed: isAnonymousAccessAllowed,
+			Cors:                     deploymentCors,
+			RequestPolicies:          route.RequestPolicies,
+			ResponsePolicies:         route.ResponsePolicies,
+			LoggingPolicies:          route.LoggingPolicies,
+			DeploymentId:             deployment.Id,
+			DeploymentDisplayName:    deployment.DisplayName,
+			PathPrefix:               deployment.PathPrefix,
+			GatewayId:                deployment.GatewayId,
+			CompartmentId:            deployment.CompartmentId,
+		}
+
+		switch backend := route.Backend.(type) {
+		case apigateway.HttpBackend:
+			info.BackendType = types.String(string(apigateway.ApiSpecificationRouteBackendTypeHttpBackend))
+			info.BackendUrl = backend.Url
+		case apigateway.OracleFunctionBackend:
+			info.BackendType = types.String(string(apigateway.ApiSpecificationRouteBackendTypeOracleFunctionsBackend))
+			info.BackendFunctionId = backend.FunctionId
+		case apigateway.StockResponseBackend:
+			info.BackendType = types.String(string(apigateway.ApiSpecificationRouteBackendTypeStockResponseBackend))
+		case apigateway.DynamicRoutingBackend:
+			info.BackendType = types.String(string(apigateway.ApiSpecificationRouteBackendTypeDynamicRoutingBackend))
+		case apigateway.OAuth2LogoutBackend:
+			info.BackendType = types.String(string(apigateway.ApiSpecificationRouteBackendTypeOauth2LogoutBackend))
+		}
+
+		if route.RequestPolicies != nil {
+			if route.RequestPolicies.Cors != nil {
+				info.Cors = route.RequestPolicies.Cors
+			}
+			info.Authorization = route.RequestPolicies.Authorization
+		}
+
+		// Routes of an authenticated deployment default to AUTHENTICATION_ONLY
+		if authenticationType != nil {
+			switch info.Authorization.(type) {
+			case apigateway.AnonymousRouteAuthorizationPolicy:
+				info.AuthorizationType = types.String(string(apigateway.RouteAuthorizationPolicyTypeAnonymous))
+			case apigateway.AnyOfRouteAuthorizationPolicy:
+				info.AuthorizationType = types.String(string(apigateway.RouteAuthorizationPolicyTypeAnyOf))
+			default:
+				info.AuthorizationType = types.String(string(apigateway.RouteAuthorizationPolicyTypeAuthenticationOnly))
+			}
+			// ANONYMOUS authorization only takes effect if the authentication
+			// policy allows anonymous access
+			isAnonymous := *info.AuthorizationType == string(apigateway.RouteAuthorizationPolicyTypeAnonymous) && *isAnonymousAccessAllowed
+			info.IsAuthenticationRequired = !isAnonymous
+		}
+
+		if len(route.Methods) == 0 {
+			routes = append(routes, info)
+			continue
+		}
+		for _, method := range route.Methods {
+			info.Method = types.String(string(method))
+			routes = append(routes, info)
+		}
+	}
+
+	return routes
+}
+
+func apiGatewayAuthenticationType(policy apigateway.AuthenticationPolicy) *string {
+	switch policy.(type) {
+	case apigateway.JwtAuthenticationPolicy:
+		return types.String(string(apigateway.AuthenticationPolicyTypeJwtAuthentication))
+	case apigateway.TokenAuthenticationPolicy:
+		return types.String(string(apigateway.AuthenticationPolicyTypeTokenAuthentication))
+	case apigateway.CustomAuthenticationPolicy:
+		return types.String(string(apigateway.AuthenticationPolicyTypeCustomAuthentication))
+	}
+
+	// Policy types unknown to the SDK are returned as a generic policy that
+	// still carries the type reported by the API
+	var policyType struct {
+		Type string `json:"type"`
+	}
+	if data, err := json.Marshal(policy); err == nil && json.Unmarshal(data, &policyType) == nil && policyType.Type != "" {
+		return types.String(policyType.Type)
+	}
+	return types.String("UNKNOWN")
+}
+
+//// TRANSFORM FUNCTION
+
+func apiGatewayDeploymentRouteTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	route := d.HydrateItem.(apiGatewayDeploymentRouteInfo)
+	path := types.SafeString(route.PathPrefix) + types.SafeString(route.Path)
+	if route.Method == nil {
+		return path, nil
+	}
+	return *route.Method + " " + path, nil
+}
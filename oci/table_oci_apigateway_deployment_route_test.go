@@ -0,0 +1,174 @@
+package oci
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/oracle/oci-go-sdk/v65/apigateway"
+	"github.com/turbot/go-kit/types"
+)
+
+const apiGatewayDeploymentFixture = `{
+	"id": "ocid1.apideployment.oc1.iad.aaaa",
+	"gatewayId": "ocid1.apigateway.oc1.iad.aaaa",
+	"compartmentId": "ocid1.compartment.oc1..aaaa",
+	"pathPrefix": "/v1",
+	"endpoint": "https://example.apigateway.us-ashburn-1.oci.customer-oci.com/v1",
+	"specification": {
+		"requestPolicies": {
+			"authentication": {
+				"type": "CUSTOM_AUTHENTICATION",
+				"functionId": "ocid1.fnfunc.oc1.iad.auth",
+				"isAnonymousAccessAllowed": true
+			},
+			"cors": {"allowedOrigins": ["https://example.com"]}
+		},
+		"routes": [
+			{
+				"path": "/orders",
+				"methods": ["GET", "POST"],
+				"backend": {"type": "HTTP_BACKEND", "url": "https://orders.internal"}
+			},
+			{
+				"path": "/health",
+				"backend": {"type": "STOCK_RESPONSE_BACKEND", "status": 200},
+				"requestPolicies": {
+					"authorization": {"type": "ANONYMOUS"},
+					"cors": {"allowedOrigins": ["*"]}
+				}
+			}
+		]
+	}
+}`
+
+func TestApiGatewayDeploymentRoutes(t *testing.T) {
+	var deployment apigateway.Deployment
+	if err := json.Unmarshal([]byte(apiGatewayDeploymentFixture), &deployment); err != nil {
+		t.Fatal(err)
+	}
+
+	routes := apiGatewayDeploymentRoutes(deployment)
+	if len(routes) != 3 {
+		t.Fatalf("expected 3 routes, got %d", len(routes))
+	}
+
+	get, post, health := routes[0], routes[1], routes[2]
+	if types.SafeString(get.Method) != "GET" || types.SafeString(post.Method) != "POST" || health.Method != nil {
+		t.Errorf("unexpected methods %v %v %v", get.Method, post.Method, health.Method)
+	}
+	if types.SafeString(get.Endpoint) != "https://example.apigateway.us-ashburn-1.oci.customer-oci.com/v1/orders" {
+		t.Errorf("unexpected endpoint %s", types.SafeString(get.Endpoint))
+	}
+	if types.SafeString(get.BackendType) != "HTTP_BACKEND" || types.SafeString(get.BackendUrl) != "https://orders.internal" {
+		t.Errorf("unexpected backend %v %v", get.BackendType, get.BackendUrl)
+	}
+	if types.SafeString(get.AuthenticationType) != "CUSTOM_AUTHENTICATION" || types.SafeString(get.AuthorizationType) != "AUTHENTICATION_ONLY" || !get.IsAuthenticationRequired {
+		t.Errorf("unexpected authentication for GET /orders %v %v %v", get.AuthenticationType, get.AuthorizationType, get.IsAuthenticationRequired)
+	}
+	if get.Cors == nil || get.Cors.AllowedOrigins[0] != "https://example.com" {
+		t.Errorf("expected deployment CORS policy on GET /orders, got %+v", get.Cors)
+	}
+
+	if types.SafeString(health.BackendType) != "STOCK_RESPONSE_BACKEND" {
+		t.Errorf("unexpected backend type %v", health.BackendType)
+	}
+	if !types.BoolValue(health.IsAnonymousAccessAllowed) || types.SafeString(health.AuthorizationType) != "ANONYMOUS" || health.IsAuthenticationRequired {
+		t.Errorf("expected anonymous /health, got %v %v %v", health.IsAnonymousAccessAllowed, health.AuthorizationType, health.IsAuthenticationRequired)
+	}
+	if health.Cors == nil || health.Cors.AllowedOrigins[0] != "*" {
+		t.Errorf("expected route CORS policy on /health, got %+v", health.Cors)
+	}
+}
+
+func TestApiGatewayDeploymentRoutesWithoutAnonymousAccess(t *testing.T) {
+	var deployment apigateway.Deployment
+	fixture := strings.Replace(apiGatewayDeploymentFixture, `"isAnonymousAccessAllowed": true`, `"isAnonymousAccessAllowed": false`, 1)
+	if err := json.Unmarshal([]byte(fixture), &deployment); err != nil {
+		t.Fatal(err)
+	}
+
+	// ANONYMOUS authorization has no effect unless the deployment allows it
+	health := apiGatewayDeploymentRoutes(deployment)[2]
+	if types.BoolValue(health.IsAnonymousAccessAllowed) || types.SafeString(health.AuthorizationType) != "ANONYMOUS" || !health.IsAuthenticationRequired {
+		t.Errorf("expected authenticated /health, got %v %v %v", health.IsAnonymousAccessAllowed, health.AuthorizationType, health.IsAuthenticationRequired)
+	}
+}
+
+func TestApiGatewayDeploymentRoutesWithDynamicAuthentication(t *testing.T) {
+	deployment := apigateway.Deployment{
+		Specification: &apigateway.ApiSpecification{
+			RequestPolicies: &apigateway.ApiSpecificationRequestPolicies{
+				DynamicAuthentication: &apigateway.DynamicAuthenticationPolicy{
+					AuthenticationServers: []apigateway.AuthenticationServerPolicy{
+						{AuthenticationServerDetail: apigateway.JwtAuthenticationPolicy{}},
+						{AuthenticationServerDetail: apigateway.CustomAuthenticationPolicy{IsAnonymousAccessAllowed: types.Bool(true)}},
+					},
+				},
+			},
+			Routes: []apigateway.ApiSpecificationRoute{
+				{
+					Path: types.String("/public"),
+					RequestPolicies: &apigateway.ApiSpecificationRouteRequestPolicies{
+						Authorization: apigateway.AnonymousRouteAuthorizationPolicy{},
+					},
+				},
+			},
+		},
+	}
+
+	route := apiGatewayDeploymentRoutes(deployment)[0]
+	if types.SafeString(route.AuthenticationType) != "DYNAMIC_AUTHENTICATION" || !types.BoolValue(route.IsAnonymousAccessAllowed) || route.IsAuthenticationRequired {
+		t.Errorf("expected anonymous /public, got %v %v %v", route.AuthenticationType, route.IsAnonymousAccessAllowed, route.IsAuthenticationRequired)
+	}
+}
+
+func TestApiGatewayDeploymentRoutesWithoutAuthentication(t *testing.T) {
+	deployment := apigateway.Deployment{
+		Specification: &apigateway.ApiSpecification{
+			Routes: []apigateway.ApiSpecificationRoute{
+				{
+					Path:    types.String("/fn"),
+					Methods: []apigateway.ApiSpecificationRouteMethodsEnum{apigateway.ApiSpecificationRouteMethodsAny},
+					Backend: apigateway.OracleFunctionBackend{FunctionId: types.String("ocid1.fnfunc.oc1.iad.aaaa")},
+				},
+			},
+		},
+	}
+
+	routes := apiGatewayDeploymentRoutes(deployment)
+	if len(routes) != 1 {
+		t.Fatalf("expected 1 route, got %d", len(routes))
+	}
+	route := routes[0]
+	if route.AuthenticationType != nil || route.AuthorizationType != nil || route.IsAnonymousAccessAllowed != nil || route.IsAuthenticationRequired {
+		t.Errorf("expected unauthenticated route, got %+v", route)
+	}
+	if types.SafeString(route.BackendFunctionId) != "ocid1.fnfunc.oc1.iad.aaaa" {
+		t.Errorf("unexpected function id %v", route.BackendFunctionId)
+	}
+}
+
+func TestApiGatewayDeploymentRoutesWithUnknownAuthentication(t *testing.T) {
+	var deployment apigateway.Deployment
+	fixture := strings.Replace(apiGatewayDeploymentFixture, `"type": "CUSTOM_AUTHENTICATION"`, `"type": "MTLS_AUTHENTICATION"`, 1)
+	if err := json.Unmarshal([]byte(fixture), &deployment); err != nil {
+		t.Fatal(err)
+	}
+
+	// Policy types unknown to the SDK still mark the routes as authenticated
+	get := apiGatewayDeploymentRoutes(deployment)[0]
+	if types.SafeString(get.AuthenticationType) != "MTLS_AUTHENTICATION" || types.SafeString(get.AuthorizationType) != "AUTHENTICATION_ONLY" || !get.IsAuthenticationRequired {
+		t.Errorf("expected authenticated GET /orders, got %v %v %v", get.AuthenticationType, get.AuthorizationType, get.IsAuthenticationRequired)
+	}
+
+	if authenticationType := apiGatewayAuthenticationType(unknownAuthenticationPolicy{}); types.SafeString(authenticationType) != "UNKNOWN" {
+		t.Errorf("expected UNKNOWN authentication type, got %v", authenticationType)
+	}
+}
+
+type unknownAuthenticationPolicy struct{}
+
+func (unknownAuthenticationPolicy) GetIsAnonymousAccessAllowed() *bool {
+	return nil
+}
@@ -0,0 +1,343 @@
+package oci
+
+import (
+	"context"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/apigateway"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableApiGatewayGateway(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_apigateway_gateway",
+		Description:      "OCI API Gateway Gateway",
+		DefaultTransform: transform.FromCamel(),
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getApiGatewayGateway,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listApiGatewayGateways,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "certificate_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "display_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "lifecycle_state",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "display_name",
+				Description: "A user-friendly name of the gateway.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The OCID of the gateway.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "endpoint_type",
+				Description: "Whether the gateway is reachable from the internet (PUBLIC) or only from within its VCN (PRIVATE).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "hostname",
+				Description: "The hostname of the gateway.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_state",
+				Description: "The current state of the gateway.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_details",
+				Description: "A message describing the current state in more detail.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "subnet_id",
+				Description: "The OCID of the subnet in which the gateway is deployed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "certificate_id",
+				Description: "The OCID of the API Gateway certificate used by the gateway.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_created",
+				Description: "The date and time the gateway was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeCreated.Time"),
+			},
+			{
+				Name:        "time_updated",
+				Description: "The date and time the gateway was updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeUpdated.Time"),
+			},
+
+			// json fields
+			{
+				Name:        "network_security_group_ids",
+				Description: "The OCIDs of the network security groups associated with the gateway.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "ip_addresses",
+				Description: "The IP addresses of the gateway.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getApiGatewayGateway,
+			},
+			{
+				Name:        "response_cache_details",
+				Description: "The response cache configuration of the gateway.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getApiGatewayGateway,
+			},
+			{
+				Name:        "ca_bundles",
+				Description: "The CA bundles used to verify backend certificates.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getApiGatewayGateway,
+			},
+
+			// tags
+			{
+				Name:        "defined_tags",
+				Description: ColumnDescriptionDefinedTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "freeform_tags",
+				Description: ColumnDescriptionFreefromTags,
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(apiGatewayGatewayTags),
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id").Transform(ociRegionName),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listApiGatewayGateways(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Trace("listApiGatewayGateways", "Compartment", compartment, "OCI_REGION", region)
+
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given compartment_id doesn't match
+	if equalQuals["compartment_id"] != nil && compartment != equalQuals["compartment_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := apiGatewayService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_apigateway_gateway.listApiGatewayGateways", "connection_error", err)
+		return nil, err
+	}
+
+	// Build request parameters
+	request := buildApiGatewayGatewayFilters(equalQuals)
+	request.CompartmentId = types.String(compartment)
+	request.Limit = types.Int(1000)
+	request.RequestMetadata = common.RequestMetadata{
+		RetryPolicy: getDefaultRetryPolicy(d.Connection),
+	}
+
+	// Check for limit
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < int64(*request.Limit) {
+			request.Limit = types.Int(int(*limit))
+		}
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.ApiGatewayGatewayClient.ListGateways(ctx, request)
+		if err != nil {
+			logger.Error("oci_apigateway_gateway.listApiGatewayGateways", "api_error", err)
+			return nil, err
+		}
+
+		for _, gateway := range response.Items {
+			d.StreamListItem(ctx, gateway)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTION
+
+func getApiGatewayGateway(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Trace("getApiGatewayGateway", "Compartment", compartment, "OCI_REGION", region)
+
+	var id string
+	if h.Item != nil {
+		id = *h.Item.(apigateway.GatewaySummary).Id
+	} else {
+		id = d.KeyColumnQuals["id"].GetStringValue()
+		// Restrict the api call to only root compartment/ per region
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+			return nil, nil
+		}
+	}
+
+	// handle empty id in get call
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := apiGatewayService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_apigateway_gateway.getApiGatewayGateway", "connection_error", err)
+		return nil, err
+	}
+
+	request := apigateway.GetGatewayRequest{
+		GatewayId: types.String(id),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.ApiGatewayGatewayClient.GetGateway(ctx, request)
+	if err != nil {
+		logger.Error("oci_apigateway_gateway.getApiGatewayGateway", "api_error", err)
+		return nil, err
+	}
+
+	return response.Gateway, nil
+}
+
+//// TRANSFORM FUNCTION
+
+// Priority order for tags
+// 1. Defined Tags
+// 2. Free-form tags
+func apiGatewayGatewayTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	var freeformTags map[string]string
+	var definedTags map[string]map[string]interface{}
+
+	switch gateway := d.HydrateItem.(type) {
+	case apigateway.GatewaySummary:
+		freeformTags = gateway.FreeformTags
+		definedTags = gateway.DefinedTags
+	case apigateway.Gateway:
+		freeformTags = gateway.FreeformTags
+		definedTags = gateway.DefinedTags
+	}
+
+	var tags map[string]interface{}
+
+	if freeformTags != nil {
+		tags = map[string]interface{}{}
+		for k, v := range freeformTags {
+			tags[k] = v
+		}
+	}
+
+	if definedTags != nil {
+		if tags == nil {
+			tags = map[string]interface{}{}
+		}
+		for _, v := range definedTags {
+			for key, value := range v {
+				tags[key] = value
+			}
+		}
+	}
+
+	return tags, nil
+}
+
+// Build additional filters
+func buildApiGatewayGatewayFilters(equalQuals plugin.KeyColumnEqualsQualMap) apigateway.ListGatewaysRequest {
+	request := apigateway.ListGatewaysRequest{}
+
+	if equalQuals["certificate_id"] != nil {
+		request.CertificateId = types.String(equalQuals["certificate_id"].GetStringValue())
+	}
+	if equalQuals["display_name"] != nil {
+		request.DisplayName = types.String(equalQuals["display_name"].GetStringValue())
+	}
+	if equalQuals["lifecycle_state"] != nil {
+		request.LifecycleState = apigateway.GatewayLifecycleStateEnum(equalQuals["lifecycle_state"].GetStringValue())
+	}
+
+	return request
+}
@@ -0,0 +1,333 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/certificates"
+	"github.com/oracle/oci-go-sdk/v65/certificatesmanagement"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableCertificatesManagementCa(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_certificates_management_ca",
+		Description:      "OCI Certificates Management Certificate Authority",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			Hydrate: listCertificatesManagementCas,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "issuer_certificate_authority_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "lifecycle_state",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "name",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: HydratedCertificateColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "A user-friendly name for the certificate authority (CA).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The OCID of the certificate authority (CA).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_state",
+				Description: "The current lifecycle state of the certificate authority.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "config_type",
+				Description: "The origin of the CA, e.g. ROOT_CA_GENERATED_INTERNALLY or SUBORDINATE_CA_ISSUED_BY_INTERNAL_CA.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "issuer_certificate_authority_id",
+				Description: "The OCID of the parent CA which issued this CA. If this CA is the root CA, then this value is the same as the id.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kms_key_id",
+				Description: "The OCID of the Oracle Cloud Infrastructure Vault key used to encrypt the CA.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "signing_algorithm",
+				Description: "The algorithm used to sign public key certificates that the CA issues.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "A brief description of the CA.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "current_version_number",
+				Description: "The version number of the current CA version.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("CurrentVersionSummary.VersionNumber"),
+			},
+			{
+				Name:        "time_created",
+				Description: "The time the CA was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeCreated.Time"),
+			},
+			{
+				Name:        "time_of_deletion",
+				Description: "An optional property indicating when to delete the CA.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeOfDeletion.Time"),
+			},
+			{
+				Name:        "certificate_authority_rules",
+				Description: "An optional list of rules that control how the CA is used and managed.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "current_version",
+				Description: "The details of the current CA version.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CurrentVersionSummary"),
+			},
+			{
+				Name:        "certificate_pem",
+				Description: "The current CA certificate in PEM format.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCertificatesManagementCaPem,
+				Transform:   transform.FromField("CertificatePem"),
+			},
+			{
+				Name:        "cert_chain_pem",
+				Description: "The certificate chain, in PEM format, of the current CA certificate.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCertificatesManagementCaPem,
+				Transform:   transform.FromField("CertChainPem"),
+			},
+
+			// tags
+			{
+				Name:        "defined_tags",
+				Description: ColumnDescriptionDefinedTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "freeform_tags",
+				Description: ColumnDescriptionFreefromTags,
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(certificatesManagementCaTags),
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id").Transform(ociRegionName),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		}, getCertificatesManagementCaPem),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCertificatesManagementCas(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Trace("listCertificatesManagementCas", "Compartment", compartment, "OCI_REGION", region)
+
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given compartment_id doesn't match
+	if equalQuals["compartment_id"] != nil && compartment != equalQuals["compartment_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := certificatesManagementService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_certificates_management_ca.listCertificatesManagementCas", "connection_error", err)
+		return nil, err
+	}
+
+	// Build request parameters
+	request := buildCertificatesManagementCaFilters(equalQuals)
+	request.CompartmentId = types.String(compartment)
+	request.Limit = types.Int(1000)
+	request.RequestMetadata = common.RequestMetadata{
+		RetryPolicy: getDefaultRetryPolicy(d.Connection),
+	}
+
+	// Check for limit
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < int64(*request.Limit) {
+			request.Limit = types.Int(int(*limit))
+		}
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.CertificatesManagementClient.ListCertificateAuthorities(ctx, request)
+		if err != nil {
+			logger.Error("oci_certificates_management_ca.listCertificatesManagementCas", "api_error", err)
+			return nil, err
+		}
+
+		for _, ca := range response.Items {
+			d.StreamListItem(ctx, ca)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTION
+
+// getCertificatesManagementCaPem fetches the bundle of the current CA version
+// and parses its PEM
+func getCertificatesManagementCaPem(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	ca := h.Item.(certificatesmanagement.CertificateAuthoritySummary)
+	region := string(ociRegionNameFromId(*ca.Id))
+
+	// CAs pending deletion have no bundle to fetch
+	if ca.LifecycleState != certificatesmanagement.CertificateAuthorityLifecycleStateActive {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := certificatesManagementService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_certificates_management_ca.getCertificatesManagementCaPem", "connection_error", err)
+		return nil, err
+	}
+
+	request := certificates.GetCertificateAuthorityBundleRequest{
+		CertificateAuthorityId: ca.Id,
+		Stage:                  certificates.GetCertificateAuthorityBundleStageCurrent,
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.CertificatesClient.GetCertificateAuthorityBundle(ctx, request)
+	if err != nil {
+		if ociErr, ok := err.(common.ServiceError); ok && ociErr.GetHTTPStatusCode() == 404 {
+			return nil, nil
+		}
+		logger.Error("oci_certificates_management_ca.getCertificatesManagementCaPem", "api_error", err)
+		return nil, err
+	}
+
+	return buildCertificatePemInfo(ctx, response.CertificatePem, response.CertChainPem), nil
+}
+
+//// TRANSFORM FUNCTION
+
+func certificatesManagementCaTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	ca := d.HydrateItem.(certificatesmanagement.CertificateAuthoritySummary)
+
+	var tags map[string]interface{}
+
+	if ca.FreeformTags != nil {
+		tags = map[string]interface{}{}
+		for k, v := range ca.FreeformTags {
+			tags[k] = v
+		}
+	}
+
+	if ca.DefinedTags != nil {
+		if tags == nil {
+			tags = map[string]interface{}{}
+		}
+		for _, v := range ca.DefinedTags {
+			for key, value := range v {
+				tags[key] = value
+			}
+		}
+	}
+
+	return tags, nil
+}
+
+// Build additional filters
+func buildCertificatesManagementCaFilters(equalQuals plugin.KeyColumnEqualsQualMap) certificatesmanagement.ListCertificateAuthoritiesRequest {
+	request := certificatesmanagement.ListCertificateAuthoritiesRequest{}
+
+	if equalQuals["id"] != nil {
+		request.CertificateAuthorityId = types.String(equalQuals["id"].GetStringValue())
+	}
+	if equalQuals["issuer_certificate_authority_id"] != nil {
+		request.IssuerCertificateAuthorityId = types.String(equalQuals["issuer_certificate_authority_id"].GetStringValue())
+	}
+	if equalQuals["lifecycle_state"] != nil {
+		request.LifecycleState = certificatesmanagement.ListCertificateAuthoritiesLifecycleStateEnum(equalQuals["lifecycle_state"].GetStringValue())
+	}
+	if equalQuals["name"] != nil {
+		request.Name = types.String(equalQuals["name"].GetStringValue())
+	}
+
+	return request
+}
@@ -0,0 +1,332 @@
+package oci
+
+import (
+	"context"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/certificates"
+	"github.com/oracle/oci-go-sdk/v65/certificatesmanagement"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableCertificatesManagementCaBundle(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_certificates_management_ca_bundle",
+		Description:      "OCI Certificates Management CA Bundle",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			Hydrate: listCertificatesManagementCaBundles,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "lifecycle_state",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "name",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "A user-friendly name for the CA bundle.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The OCID of the CA bundle.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_state",
+				Description: "The current lifecycle state of the CA bundle.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_details",
+				Description: "Additional information about the current lifecycle state of the CA bundle.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "A brief description of the CA bundle.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_created",
+				Description: "The time the CA bundle was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeCreated.Time"),
+			},
+			{
+				Name:        "certificate_count",
+				Description: "The number of certificates in the CA bundle.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getCertificatesManagementCaBundlePem,
+			},
+			{
+				Name:        "not_after",
+				Description: "The earliest time after which a certificate in the CA bundle is not valid.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getCertificatesManagementCaBundlePem,
+			},
+			{
+				Name:        "certificates",
+				Description: "The parsed certificates of the CA bundle, including subject, issuer, subject alternative names, key algorithm and validity.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCertificatesManagementCaBundlePem,
+			},
+			{
+				Name:        "certificate_parse_error",
+				Description: "The error raised while parsing the PEM encoded CA bundle, if any.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCertificatesManagementCaBundlePem,
+			},
+			{
+				Name:        "ca_bundle_pem",
+				Description: "Certificates, in PEM format, in the CA bundle.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCertificatesManagementCaBundlePem,
+			},
+
+			// tags
+			{
+				Name:        "defined_tags",
+				Description: ColumnDescriptionDefinedTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "freeform_tags",
+				Description: ColumnDescriptionFreefromTags,
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(certificatesManagementCaBundleTags),
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id").Transform(ociRegionName),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+// caBundlePemInfo is the PEM of a CA bundle along with its parsed certificates
+type caBundlePemInfo struct {
+	CaBundlePem           *string
+	CertificateCount      int
+	NotAfter              *time.Time
+	Certificates          []*certificateDetails
+	CertificateParseError *string
+}
+
+//// LIST FUNCTION
+
+func listCertificatesManagementCaBundles(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Trace("listCertificatesManagementCaBundles", "Compartment", compartment, "OCI_REGION", region)
+
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given compartment_id doesn't match
+	if equalQuals["compartment_id"] != nil && compartment != equalQuals["compartment_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := certificatesManagementService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_certificates_management_ca_bundle.listCertificatesManagementCaBundles", "connection_error", err)
+		return nil, err
+	}
+
+	// Build request parameters
+	request := buildCertificatesManagementCaBundleFilters(equalQuals)
+	request.CompartmentId = types.String(compartment)
+	request.Limit = types.Int(1000)
+	request.RequestMetadata = common.RequestMetadata{
+		RetryPolicy: getDefaultRetryPolicy(d.Connection),
+	}
+
+	// Check for limit
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < int64(*request.Limit) {
+			request.Limit = types.Int(int(*limit))
+		}
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.CertificatesManagementClient.ListCaBundles(ctx, request)
+		if err != nil {
+			logger.Error("oci_certificates_management_ca_bundle.listCertificatesManagementCaBundles", "api_error", err)
+			return nil, err
+		}
+
+		for _, bundle := range response.Items {
+			d.StreamListItem(ctx, bundle)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTION
+
+// getCertificatesManagementCaBundlePem fetches the PEM of the CA bundle and
+// parses every certificate in it
+func getCertificatesManagementCaBundlePem(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	bundle := h.Item.(certificatesmanagement.CaBundleSummary)
+	region := string(ociRegionNameFromId(*bundle.Id))
+
+	if bundle.LifecycleState != certificatesmanagement.CaBundleLifecycleStateActive {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := certificatesManagementService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_certificates_management_ca_bundle.getCertificatesManagementCaBundlePem", "connection_error", err)
+		return nil, err
+	}
+
+	request := certificates.GetCaBundleRequest{
+		CaBundleId: bundle.Id,
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.CertificatesClient.GetCaBundle(ctx, request)
+	if err != nil {
+		if ociErr, ok := err.(common.ServiceError); ok && ociErr.GetHTTPStatusCode() == 404 {
+			return nil, nil
+		}
+		logger.Error("oci_certificates_management_ca_bundle.getCertificatesManagementCaBundlePem", "api_error", err)
+		return nil, err
+	}
+
+	item := &caBundlePemInfo{CaBundlePem: response.CaBundlePem}
+	if response.CaBundlePem == nil {
+		return item, nil
+	}
+
+	// Keep any certificates parsed before an invalid one
+	parsed, err := parsePEMCertificates(*response.CaBundlePem)
+	if err != nil {
+		logger.Debug("oci_certificates_management_ca_bundle.getCertificatesManagementCaBundlePem", "parse_error", err, "id", types.SafeString(bundle.Id))
+		item.CertificateParseError = types.String(err.Error())
+	}
+	item.Certificates = parsed
+	item.CertificateCount = len(parsed)
+	for _, certificate := range parsed {
+		if item.NotAfter == nil || certificate.NotAfter.Before(*item.NotAfter) {
+			notAfter := certificate.NotAfter
+			item.NotAfter = &notAfter
+		}
+	}
+
+	return item, nil
+}
+
+//// TRANSFORM FUNCTION
+
+func certificatesManagementCaBundleTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	bundle := d.HydrateItem.(certificatesmanagement.CaBundleSummary)
+
+	var tags map[string]interface{}
+
+	if bundle.FreeformTags != nil {
+		tags = map[string]interface{}{}
+		for k, v := range bundle.FreeformTags {
+			tags[k] = v
+		}
+	}
+
+	if bundle.DefinedTags != nil {
+		if tags == nil {
+			tags = map[string]interface{}{}
+		}
+		for _, v := range bundle.DefinedTags {
+			for key, value := range v {
+				tags[key] = value
+			}
+		}
+	}
+
+	return tags, nil
+}
+
+// Build additional filters
+func buildCertificatesManagementCaBundleFilters(equalQuals plugin.KeyColumnEqualsQualMap) certificatesmanagement.ListCaBundlesRequest {
+	request := certificatesmanagement.ListCaBundlesRequest{}
+
+	if equalQuals["id"] != nil {
+		request.CaBundleId = types.String(equalQuals["id"].GetStringValue())
+	}
+	if equalQuals["lifecycle_state"] != nil {
+		request.LifecycleState = certificatesmanagement.ListCaBundlesLifecycleStateEnum(equalQuals["lifecycle_state"].GetStringValue())
+	}
+	if equalQuals["name"] != nil {
+		request.Name = types.String(equalQuals["name"].GetStringValue())
+	}
+
+	return request
+}
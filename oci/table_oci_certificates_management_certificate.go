@@ -0,0 +1,360 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/certificates"
+	"github.com/oracle/oci-go-sdk/v65/certificatesmanagement"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableCertificatesManagementCertificate(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_certificates_management_certificate",
+		Description:      "OCI Certificates Management Certificate",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			Hydrate: listCertificatesManagementCertificates,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "issuer_certificate_authority_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "lifecycle_state",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "name",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: HydratedCertificateColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "A user-friendly name for the certificate.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The OCID of the certificate.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_state",
+				Description: "The current lifecycle state of the certificate.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "config_type",
+				Description: "The origin of the certificate, e.g. ISSUED_BY_INTERNAL_CA, MANAGED_EXTERNALLY_ISSUED_BY_INTERNAL_CA or IMPORTED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "issuer_certificate_authority_id",
+				Description: "The OCID of the certificate authority (CA) that issued the certificate.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "certificate_profile_type",
+				Description: "The name of the profile used to create the certificate, which depends on the type of certificate you need.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "A brief description of the certificate.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "current_version_number",
+				Description: "The version number of the current certificate version.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("CurrentVersionSummary.VersionNumber"),
+			},
+			{
+				Name:        "time_created",
+				Description: "The time the certificate was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeCreated.Time"),
+			},
+			{
+				Name:        "time_of_deletion",
+				Description: "An optional property indicating when to delete the certificate.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeOfDeletion.Time"),
+			},
+			{
+				Name:        "certificate_rules",
+				Description: "A list of rules that control how the certificate is used and managed.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "current_version",
+				Description: "The details of the current certificate version.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CurrentVersionSummary"),
+			},
+			{
+				Name:        "certificate_pem",
+				Description: "The current certificate in PEM format.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCertificatesManagementCertificatePem,
+				Transform:   transform.FromField("CertificatePem"),
+			},
+			{
+				Name:        "cert_chain_pem",
+				Description: "The certificate chain, in PEM format, of the current certificate.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCertificatesManagementCertificatePem,
+				Transform:   transform.FromField("CertChainPem"),
+			},
+
+			// tags
+			{
+				Name:        "defined_tags",
+				Description: ColumnDescriptionDefinedTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "freeform_tags",
+				Description: ColumnDescriptionFreefromTags,
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(certificatesManagementCertificateTags),
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id").Transform(ociRegionName),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		}, getCertificatesManagementCertificatePem),
+	}
+}
+
+// certificatePemInfo is a PEM encoded certificate fetched from the certificates
+// service, along with the result of parsing it
+type certificatePemInfo struct {
+	CertificatePem        *string
+	CertChainPem          *string
+	CertificateDetails    *certificateDetails
+	CertificateParseError *string
+}
+
+//// LIST FUNCTION
+
+func listCertificatesManagementCertificates(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Trace("listCertificatesManagementCertificates", "Compartment", compartment, "OCI_REGION", region)
+
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given compartment_id doesn't match
+	if equalQuals["compartment_id"] != nil && compartment != equalQuals["compartment_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := certificatesManagementService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_certificates_management_certificate.listCertificatesManagementCertificates", "connection_error", err)
+		return nil, err
+	}
+
+	// Build request parameters
+	request := buildCertificatesManagementCertificateFilters(equalQuals)
+	request.CompartmentId = types.String(compartment)
+	request.Limit = types.Int(1000)
+	request.RequestMetadata = common.RequestMetadata{
+		RetryPolicy: getDefaultRetryPolicy(d.Connection),
+	}
+
+	// Check for limit
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < int64(*request.Limit) {
+			request.Limit = types.Int(int(*limit))
+		}
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.CertificatesManagementClient.ListCertificates(ctx, request)
+		if err != nil {
+			logger.Error("oci_certificates_management_certificate.listCertificatesManagementCertificates", "api_error", err)
+			return nil, err
+		}
+
+		for _, certificate := range response.Items {
+			d.StreamListItem(ctx, certificate)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTION
+
+// getCertificatesManagementCertificatePem fetches the public bundle of the
+// current certificate version and parses its PEM
+func getCertificatesManagementCertificatePem(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	certificate := h.Item.(certificatesmanagement.CertificateSummary)
+	region := string(ociRegionNameFromId(*certificate.Id))
+
+	// Certificates pending deletion have no bundle to fetch
+	if certificate.LifecycleState != certificatesmanagement.CertificateLifecycleStateActive {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := certificatesManagementService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_certificates_management_certificate.getCertificatesManagementCertificatePem", "connection_error", err)
+		return nil, err
+	}
+
+	request := certificates.GetCertificateBundleRequest{
+		CertificateId:         certificate.Id,
+		Stage:                 certificates.GetCertificateBundleStageCurrent,
+		CertificateBundleType: certificates.GetCertificateBundleCertificateBundleTypePublicOnly,
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.CertificatesClient.GetCertificateBundle(ctx, request)
+	if err != nil {
+		if ociErr, ok := err.(common.ServiceError); ok && ociErr.GetHTTPStatusCode() == 404 {
+			return nil, nil
+		}
+		logger.Error("oci_certificates_management_certificate.getCertificatesManagementCertificatePem", "api_error", err)
+		return nil, err
+	}
+
+	return buildCertificatePemInfo(ctx, response.CertificateBundle.GetCertificatePem(), response.CertificateBundle.GetCertChainPem()), nil
+}
+
+// buildCertificatePemInfo parses the PEM returned by the certificates service
+func buildCertificatePemInfo(ctx context.Context, certificatePem *string, certChainPem *string) *certificatePemInfo {
+	item := &certificatePemInfo{
+		CertificatePem: certificatePem,
+		CertChainPem:   certChainPem,
+	}
+
+	if certificatePem == nil {
+		return item
+	}
+
+	details, err := parsePEMCertificate(*certificatePem)
+	if err != nil {
+		plugin.Logger(ctx).Debug("buildCertificatePemInfo", "parse_error", err)
+		item.CertificateParseError = types.String(err.Error())
+	} else {
+		item.CertificateDetails = details
+	}
+
+	return item
+}
+
+//// TRANSFORM FUNCTION
+
+func certificatesManagementCertificateTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	certificate := d.HydrateItem.(certificatesmanagement.CertificateSummary)
+
+	var tags map[string]interface{}
+
+	if certificate.FreeformTags != nil {
+		tags = map[string]interface{}{}
+		for k, v := range certificate.FreeformTags {
+			tags[k] = v
+		}
+	}
+
+	if certificate.DefinedTags != nil {
+		if tags == nil {
+			tags = map[string]interface{}{}
+		}
+		for _, v := range certificate.DefinedTags {
+			for key, value := range v {
+				tags[key] = value
+			}
+		}
+	}
+
+	return tags, nil
+}
+
+// Build additional filters
+func buildCertificatesManagementCertificateFilters(equalQuals plugin.KeyColumnEqualsQualMap) certificatesmanagement.ListCertificatesRequest {
+	request := certificatesmanagement.ListCertificatesRequest{}
+
+	if equalQuals["id"] != nil {
+		request.CertificateId = types.String(equalQuals["id"].GetStringValue())
+	}
+	if equalQuals["issuer_certificate_authority_id"] != nil {
+		request.IssuerCertificateAuthorityId = types.String(equalQuals["issuer_certificate_authority_id"].GetStringValue())
+	}
+	if equalQuals["lifecycle_state"] != nil {
+		request.LifecycleState = certificatesmanagement.ListCertificatesLifecycleStateEnum(equalQuals["lifecycle_state"].GetStringValue())
+	}
+	if equalQuals["name"] != nil {
+		request.Name = types.String(equalQuals["name"].GetStringValue())
+	}
+
+	return request
+}
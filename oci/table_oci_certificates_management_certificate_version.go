@@ -0,0 +1,236 @@
+package oci
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/oracle/oci-go-sdk/v65/certificates"
+	"github.com/oracle/oci-go-sdk/v65/certificatesmanagement"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableCertificatesManagementCertificateVersion(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_certificates_management_certificate_version",
+		Description:      "OCI Certificates Management Certificate Version",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			ParentHydrate: listCertificatesManagementCertificates,
+			Hydrate:       listCertificatesManagementCertificateVersions,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "certificate_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: HydratedCertificateColumns([]*plugin.Column{
+			{
+				Name:        "certificate_id",
+				Description: "The OCID of the certificate.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "certificate_name",
+				Description: "The name of the certificate.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "version_number",
+				Description: "The version number of the certificate.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "version_name",
+				Description: "The name of the certificate version. A name is unique across versions of a certificate.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "stages",
+				Description: "A list of rotation states for the certificate version, e.g. CURRENT, PENDING, LATEST, PREVIOUS, DEPRECATED or FAILED.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "issuer_ca_version_number",
+				Description: "The version number of the issuing certificate authority (CA).",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "time_created",
+				Description: "The time the certificate version was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeCreated.Time"),
+			},
+			{
+				Name:        "time_of_deletion",
+				Description: "An optional property indicating when to delete the certificate version.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeOfDeletion.Time"),
+			},
+			{
+				Name:        "time_of_validity_not_after",
+				Description: "The date on which the certificate validity period ends, as recorded by the service.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Validity.TimeOfValidityNotAfter.Time"),
+			},
+			{
+				Name:        "revocation_status",
+				Description: "The current revocation status of the certificate version.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "certificate_pem",
+				Description: "The certificate version in PEM format.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCertificatesManagementCertificateVersionPem,
+				Transform:   transform.FromField("CertificatePem"),
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(certificatesManagementVersionTitle),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CertificateId").Transform(ociRegionName),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		}, getCertificatesManagementCertificateVersionPem),
+	}
+}
+
+type certificateVersionInfo struct {
+	certificatesmanagement.CertificateVersionSummary
+	CertificateName *string
+	CompartmentId   *string
+}
+
+//// LIST FUNCTION
+
+func listCertificatesManagementCertificateVersions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	certificate := h.Item.(certificatesmanagement.CertificateSummary)
+
+	// Return nil, if given certificate_id doesn't match
+	if d.KeyColumnQuals["certificate_id"] != nil && types.SafeString(certificate.Id) != d.KeyColumnQuals["certificate_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := certificatesManagementService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_certificates_management_certificate_version.listCertificatesManagementCertificateVersions", "connection_error", err)
+		return nil, err
+	}
+
+	request := certificatesmanagement.ListCertificateVersionsRequest{
+		CertificateId: certificate.Id,
+		Limit:         types.Int(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.CertificatesManagementClient.ListCertificateVersions(ctx, request)
+		if err != nil {
+			logger.Error("oci_certificates_management_certificate_version.listCertificatesManagementCertificateVersions", "api_error", err)
+			return nil, err
+		}
+
+		for _, version := range response.Items {
+			d.StreamLeafListItem(ctx, certificateVersionInfo{version, certificate.Name, certificate.CompartmentId})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTION
+
+// getCertificatesManagementCertificateVersionPem fetches the public bundle of
+// the certificate version and parses its PEM
+func getCertificatesManagementCertificateVersionPem(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	version := h.Item.(certificateVersionInfo)
+	region := string(ociRegionNameFromId(*version.CertificateId))
+
+	// Create Session
+	session, err := certificatesManagementService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_certificates_management_certificate_version.getCertificatesManagementCertificateVersionPem", "connection_error", err)
+		return nil, err
+	}
+
+	request := certificates.GetCertificateBundleRequest{
+		CertificateId:         version.CertificateId,
+		VersionNumber:         version.VersionNumber,
+		CertificateBundleType: certificates.GetCertificateBundleCertificateBundleTypePublicOnly,
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.CertificatesClient.GetCertificateBundle(ctx, request)
+	if err != nil {
+		// Versions pending deletion or that failed to issue have no bundle
+		if ociErr, ok := err.(common.ServiceError); ok && ociErr.GetHTTPStatusCode() == 404 {
+			return nil, nil
+		}
+		logger.Error("oci_certificates_management_certificate_version.getCertificatesManagementCertificateVersionPem", "api_error", err)
+		return nil, err
+	}
+
+	return buildCertificatePemInfo(ctx, response.CertificateBundle.GetCertificatePem(), response.CertificateBundle.GetCertChainPem()), nil
+}
+
+//// TRANSFORM FUNCTION
+
+func certificatesManagementVersionTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	version := d.HydrateItem.(certificateVersionInfo)
+	if version.VersionName != nil {
+		return *version.VersionName, nil
+	}
+	return fmt.Sprintf("%s-%d", types.SafeString(version.CertificateName), types.Int64Value(version.VersionNumber)), nil
+}
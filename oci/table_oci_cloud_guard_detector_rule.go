@@ -0,0 +1,300 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/cloudguard"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableCloudGuardDetectorRule(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_cloud_guard_detector_rule",
+		Description:      "OCI Cloud Guard Detector Rule",
+		DefaultTransform: transform.FromCamel(),
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"detector", "id"}),
+			Hydrate:    getCloudGuardDetectorRule,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCloudGuardDetectorRules,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "detector",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "display_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "lifecycle_state",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "display_name",
+				Description: "The display name of the detector rule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique id of the detector rule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "detector",
+				Description: "The detector the rule belongs to, e.g. IAAS_ACTIVITY_DETECTOR or IAAS_CONFIGURATION_DETECTOR.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_type",
+				Description: "The service the rule applies to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_type",
+				Description: "The resource type the rule applies to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "risk_level",
+				Description: "The default risk level of problems raised by the rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DetectorDetails.RiskLevel"),
+			},
+			{
+				Name:        "is_enabled",
+				Description: "Indicates whether the rule is enabled by default.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("DetectorDetails.IsEnabled"),
+			},
+			{
+				Name:        "lifecycle_state",
+				Description: "The current state of the detector rule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_details",
+				Description: "A message describing the current state in more detail.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the detector rule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "recommendation",
+				Description: "The recommended action for problems raised by the rule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_created",
+				Description: "The date and time the detector rule was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeCreated.Time"),
+			},
+			{
+				Name:        "time_updated",
+				Description: "The date and time the detector rule was updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeUpdated.Time"),
+			},
+
+			// json fields
+			{
+				Name:        "labels",
+				Description: "The labels of the detector rule, e.g. the CIS benchmark controls it covers.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("DetectorDetails.Labels"),
+			},
+			{
+				Name:        "managed_list_types",
+				Description: "The managed list types the rule can be configured with.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "candidate_responder_rules",
+				Description: "The responder rules that can act on problems raised by the rule.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "detector_details",
+				Description: "The default settings of the detector rule.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudGuardDetectorRules(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	equalQuals := d.KeyColumnQuals
+
+	// fetch reporting region from configuration
+	getCloudGuardConfigurationCached := plugin.HydrateFunc(getCloudGuardConfiguration).WithCache()
+	configuration, err := getCloudGuardConfigurationCached(ctx, d, h)
+	if err != nil {
+		logger.Error("oci_cloud_guard_detector_rule.listCloudGuardDetectorRules", "configuration_error", err)
+		return nil, err
+	}
+
+	reportingRegion := configuration.(cloudguard.Configuration).ReportingRegion
+
+	// Create Session
+	session, err := cloudGuardService(ctx, d, *reportingRegion)
+	if err != nil {
+		logger.Error("oci_cloud_guard_detector_rule.listCloudGuardDetectorRules", "connection_error", err)
+		return nil, err
+	}
+
+	// The detectors are a fixed catalog, so only list them if no detector is given
+	var detectors []string
+	if equalQuals["detector"] != nil {
+		detectors = append(detectors, equalQuals["detector"].GetStringValue())
+	} else {
+		detectorRequest := cloudguard.ListDetectorsRequest{
+			CompartmentId: types.String(session.TenancyID),
+			Limit:         types.Int(1000),
+			RequestMetadata: common.RequestMetadata{
+				RetryPolicy: getDefaultRetryPolicy(d.Connection),
+			},
+		}
+
+		pagesLeft := true
+		for pagesLeft {
+			response, err := session.CloudGuardClient.ListDetectors(ctx, detectorRequest)
+			if err != nil {
+				logger.Error("oci_cloud_guard_detector_rule.listCloudGuardDetectorRules", "api_error", err)
+				return nil, err
+			}
+			for _, detector := range response.Items {
+				detectors = append(detectors, types.SafeString(detector.Id))
+			}
+			if response.OpcNextPage != nil {
+				detectorRequest.Page = response.OpcNextPage
+			} else {
+				pagesLeft = false
+			}
+		}
+	}
+
+	for _, detector := range detectors {
+		request := cloudguard.ListDetectorRulesRequest{
+			DetectorId:    types.String(detector),
+			CompartmentId: types.String(session.TenancyID),
+			Limit:         types.Int(1000),
+			RequestMetadata: common.RequestMetadata{
+				RetryPolicy: getDefaultRetryPolicy(d.Connection),
+			},
+		}
+
+		if equalQuals["display_name"] != nil {
+			request.DisplayName = types.String(equalQuals["display_name"].GetStringValue())
+		}
+		if equalQuals["lifecycle_state"] != nil {
+			request.LifecycleState = cloudguard.ListDetectorRulesLifecycleStateEnum(equalQuals["lifecycle_state"].GetStringValue())
+		}
+
+		pagesLeft := true
+		for pagesLeft {
+			response, err := session.CloudGuardClient.ListDetectorRules(ctx, request)
+			if err != nil {
+				logger.Error("oci_cloud_guard_detector_rule.listCloudGuardDetectorRules", "api_error", err)
+				return nil, err
+			}
+			for _, rule := range response.Items {
+				d.StreamListItem(ctx, rule)
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+			if response.OpcNextPage != nil {
+				request.Page = response.OpcNextPage
+			} else {
+				pagesLeft = false
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTION
+
+func getCloudGuardDetectorRule(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	detector := d.KeyColumnQuals["detector"].GetStringValue()
+	id := d.KeyColumnQuals["id"].GetStringValue()
+
+	// handle empty detector or id in get call
+	if detector == "" || id == "" {
+		return nil, nil
+	}
+
+	// fetch reporting region from configuration
+	getCloudGuardConfigurationCached := plugin.HydrateFunc(getCloudGuardConfiguration).WithCache()
+	configuration, err := getCloudGuardConfigurationCached(ctx, d, h)
+	if err != nil {
+		logger.Error("oci_cloud_guard_detector_rule.getCloudGuardDetectorRule", "configuration_error", err)
+		return nil, err
+	}
+
+	reportingRegion := configuration.(cloudguard.Configuration).ReportingRegion
+
+	// Create Session
+	session, err := cloudGuardService(ctx, d, *reportingRegion)
+	if err != nil {
+		logger.Error("oci_cloud_guard_detector_rule.getCloudGuardDetectorRule", "connection_error", err)
+		return nil, err
+	}
+
+	request := cloudguard.GetDetectorRuleRequest{
+		DetectorId:     types.String(detector),
+		DetectorRuleId: types.String(id),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.CloudGuardClient.GetDetectorRule(ctx, request)
+	if err != nil {
+		if ociErr, ok := err.(common.ServiceError); ok && ociErr.GetHTTPStatusCode() == 404 {
+			return nil, nil
+		}
+		logger.Error("oci_cloud_guard_detector_rule.getCloudGuardDetectorRule", "api_error", err)
+		return nil, err
+	}
+
+	return response.DetectorRule, nil
+}
@@ -0,0 +1,451 @@
+package oci
+
+import (
+	"context"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/cloudguard"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableCloudGuardProblem(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_cloud_guard_problem",
+		Description:      "OCI Cloud Guard Problem",
+		DefaultTransform: transform.FromCamel(),
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getCloudGuardProblem,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCloudGuardProblems,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "lifecycle_state",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "lifecycle_detail",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "risk_level",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "label",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "resource_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "resource_type",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "detector_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "target_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "region",
+					Require: plugin.Optional,
+				},
+				{
+					Name:      "time_last_detected",
+					Require:   plugin.Optional,
+					Operators: []string{">", ">=", "=", "<", "<="},
+				},
+				{
+					Name:      "time_first_detected",
+					Require:   plugin.Optional,
+					Operators: []string{">", ">=", "=", "<", "<="},
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartmentList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The OCID of the problem.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_name",
+				Description: "The name of the resource on which the problem was detected.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_id",
+				Description: "The OCID of the resource on which the problem was detected.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of the resource on which the problem was detected.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "risk_level",
+				Description: "The risk level of the problem, e.g. CRITICAL, HIGH, MEDIUM, LOW or MINOR.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "risk_score",
+				Description: "The risk score of the problem.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "lifecycle_state",
+				Description: "The current state of the problem.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_detail",
+				Description: "The lifecycle detail of the problem, e.g. OPEN, RESOLVED or DISMISSED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "detector_id",
+				Description: "The type of detector that raised the problem, e.g. IAAS_CONFIGURATION_DETECTOR.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "detector_rule_id",
+				Description: "The id of the detector rule that raised the problem.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "target_id",
+				Description: "The OCID of the target in which the problem was detected.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "label",
+				Description: "A label to filter problems by, e.g. CIS_OCI_V1.1_NETWORK. Use the labels column to read the labels of a problem.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("label"),
+			},
+			{
+				Name:        "time_first_detected",
+				Description: "The date and time the problem was first detected.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeFirstDetected.Time"),
+			},
+			{
+				Name:        "time_last_detected",
+				Description: "The date and time the problem was last detected.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeLastDetected.Time"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the problem.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloudGuardProblem,
+			},
+			{
+				Name:        "recommendation",
+				Description: "The recommended action to resolve the problem.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloudGuardProblem,
+			},
+			{
+				Name:        "comment",
+				Description: "The user comment on the problem.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloudGuardProblem,
+			},
+			{
+				Name:        "peak_risk_score",
+				Description: "The peak risk score of the problem.",
+				Type:        proto.ColumnType_DOUBLE,
+				Hydrate:     getCloudGuardProblem,
+			},
+			{
+				Name:        "peak_risk_score_date",
+				Description: "The date on which the peak risk score was reached.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloudGuardProblem,
+			},
+			{
+				Name:        "auto_resolve_date",
+				Description: "The date on which the problem will be automatically resolved if it is not detected again.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloudGuardProblem,
+			},
+			{
+				Name:        "impacted_resource_id",
+				Description: "The OCID of the impacted resource, if different from the resource.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloudGuardProblem,
+			},
+			{
+				Name:        "impacted_resource_name",
+				Description: "The name of the impacted resource.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloudGuardProblem,
+			},
+			{
+				Name:        "impacted_resource_type",
+				Description: "The type of the impacted resource.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloudGuardProblem,
+			},
+
+			// json fields
+			{
+				Name:        "labels",
+				Description: "The labels of the problem.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "regions",
+				Description: "The regions in which the problem was detected.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "additional_details",
+				Description: "Additional details of the problem.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudGuardProblem,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceName"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: "The region in which the problem was detected.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudGuardProblems(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Debug("oci.listCloudGuardProblems", "Compartment", compartment)
+
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given compartment_id doesn't match
+	if equalQuals["compartment_id"] != nil && compartment != equalQuals["compartment_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// fetch reporting region from configuration
+	getCloudGuardConfigurationCached := plugin.HydrateFunc(getCloudGuardConfiguration).WithCache()
+	configuration, err := getCloudGuardConfigurationCached(ctx, d, h)
+	if err != nil {
+		logger.Error("oci_cloud_guard_problem.listCloudGuardProblems", "configuration_error", err)
+		return nil, err
+	}
+
+	reportingRegion := configuration.(cloudguard.Configuration).ReportingRegion
+
+	// Create Session
+	session, err := cloudGuardService(ctx, d, *reportingRegion)
+	if err != nil {
+		logger.Error("oci_cloud_guard_problem.listCloudGuardProblems", "connection_error", err)
+		return nil, err
+	}
+
+	// Build request parameters
+	request := buildCloudGuardProblemFilters(d)
+	request.CompartmentId = types.String(compartment)
+	request.Limit = types.Int(1000)
+	request.RequestMetadata = common.RequestMetadata{
+		RetryPolicy: getDefaultRetryPolicy(d.Connection),
+	}
+
+	// Check for limit
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < int64(*request.Limit) {
+			request.Limit = types.Int(int(*limit))
+		}
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.CloudGuardClient.ListProblems(ctx, request)
+		if err != nil {
+			logger.Error("oci_cloud_guard_problem.listCloudGuardProblems", "api_error", err)
+			return nil, err
+		}
+		for _, problem := range response.Items {
+			d.StreamListItem(ctx, problem)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTION
+
+func getCloudGuardProblem(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Debug("oci.getCloudGuardProblem", "Compartment", compartment)
+
+	var id string
+	if h.Item != nil {
+		id = *h.Item.(cloudguard.ProblemSummary).Id
+	} else {
+		// Restrict the api call to only root compartment
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+			return nil, nil
+		}
+		id = d.KeyColumnQuals["id"].GetStringValue()
+	}
+
+	// handle empty id in get call
+	if id == "" {
+		return nil, nil
+	}
+
+	// fetch reporting region from configuration
+	getCloudGuardConfigurationCached := plugin.HydrateFunc(getCloudGuardConfiguration).WithCache()
+	configuration, err := getCloudGuardConfigurationCached(ctx, d, h)
+	if err != nil {
+		logger.Error("oci_cloud_guard_problem.getCloudGuardProblem", "configuration_error", err)
+		return nil, err
+	}
+
+	reportingRegion := configuration.(cloudguard.Configuration).ReportingRegion
+
+	// Create Session
+	session, err := cloudGuardService(ctx, d, *reportingRegion)
+	if err != nil {
+		logger.Error("oci_cloud_guard_problem.getCloudGuardProblem", "connection_error", err)
+		return nil, err
+	}
+
+	request := cloudguard.GetProblemRequest{
+		ProblemId: types.String(id),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.CloudGuardClient.GetProblem(ctx, request)
+	if err != nil {
+		logger.Error("oci_cloud_guard_problem.getCloudGuardProblem", "api_error", err)
+		return nil, err
+	}
+
+	return response.Problem, nil
+}
+
+// Build additional filters
+func buildCloudGuardProblemFilters(d *plugin.QueryData) cloudguard.ListProblemsRequest {
+	request := cloudguard.ListProblemsRequest{}
+	equalQuals := d.KeyColumnQuals
+
+	if equalQuals["lifecycle_state"] != nil {
+		request.LifecycleState = cloudguard.ListProblemsLifecycleStateEnum(equalQuals["lifecycle_state"].GetStringValue())
+	}
+	if equalQuals["lifecycle_detail"] != nil {
+		request.LifecycleDetail = cloudguard.ListProblemsLifecycleDetailEnum(equalQuals["lifecycle_detail"].GetStringValue())
+	}
+	if equalQuals["risk_level"] != nil {
+		request.RiskLevel = types.String(equalQuals["risk_level"].GetStringValue())
+	}
+	if equalQuals["label"] != nil {
+		request.Label = types.String(equalQuals["label"].GetStringValue())
+	}
+	if equalQuals["resource_id"] != nil {
+		request.ResourceId = types.String(equalQuals["resource_id"].GetStringValue())
+	}
+	if equalQuals["resource_type"] != nil {
+		request.ResourceType = types.String(equalQuals["resource_type"].GetStringValue())
+	}
+	if equalQuals["detector_id"] != nil {
+		request.DetectorType = cloudguard.ListProblemsDetectorTypeEnum(equalQuals["detector_id"].GetStringValue())
+	}
+	if equalQuals["target_id"] != nil {
+		request.TargetId = types.String(equalQuals["target_id"].GetStringValue())
+	}
+	if equalQuals["region"] != nil {
+		request.Region = types.String(equalQuals["region"].GetStringValue())
+	}
+
+	if d.Quals["time_last_detected"] != nil {
+		for _, q := range d.Quals["time_last_detected"].Quals {
+			timestamp := &common.SDKTime{Time: q.Value.GetTimestampValue().AsTime()}
+			switch q.Operator {
+			case ">", ">=":
+				request.TimeLastDetectedGreaterThanOrEqualTo = timestamp
+			case "<", "<=":
+				request.TimeLastDetectedLessThanOrEqualTo = timestamp
+			case "=":
+				request.TimeLastDetectedGreaterThanOrEqualTo = timestamp
+				request.TimeLastDetectedLessThanOrEqualTo = timestamp
+			}
+		}
+	}
+
+	if d.Quals["time_first_detected"] != nil {
+		for _, q := range d.Quals["time_first_detected"].Quals {
+			timestamp := &common.SDKTime{Time: q.Value.GetTimestampValue().AsTime()}
+			switch q.Operator {
+			case ">", ">=":
+				request.TimeFirstDetectedGreaterThanOrEqualTo = timestamp
+			case "<", "<=":
+				request.TimeFirstDetectedLessThanOrEqualTo = timestamp
+			case "=":
+				request.TimeFirstDetectedGreaterThanOrEqualTo = timestamp
+				request.TimeFirstDetectedLessThanOrEqualTo = timestamp
+			}
+		}
+	}
+
+	return request
+}
@@ -0,0 +1,190 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/cloudguard"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableCloudGuardProblemHistory(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_cloud_guard_problem_history",
+		Description:      "OCI Cloud Guard Problem History",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			ParentHydrate: listCloudGuardProblems,
+			Hydrate:       listCloudGuardProblemHistories,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "problem_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartmentList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The unique id of the history entry.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "problem_id",
+				Description: "The OCID of the problem.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_name",
+				Description: "The name of the resource on which the problem was detected.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "actor_type",
+				Description: "The type of actor that made the change, e.g. CLOUD_GUARD_SERVICE, CORRELATION, RESPONDER or USER.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "actor_name",
+				Description: "The name of the actor that made the change.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "explanation",
+				Description: "An explanation of the change.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_detail",
+				Description: "The lifecycle detail of the problem after the change.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "event_status",
+				Description: "The status of the event, e.g. REOPEN, OPEN, UPDATE, RESOLVE, DISMISS or DELETE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "delta",
+				Description: "The change made to the problem.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "comment",
+				Description: "The user comment on the change.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_created",
+				Description: "The date and time of the change.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeCreated.Time"),
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+type cloudGuardProblemHistoryInfo struct {
+	cloudguard.ProblemHistorySummary
+	ResourceName  *string
+	CompartmentId *string
+}
+
+//// LIST FUNCTION
+
+func listCloudGuardProblemHistories(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	problem := h.Item.(cloudguard.ProblemSummary)
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given problem_id doesn't match
+	if equalQuals["problem_id"] != nil && types.SafeString(problem.Id) != equalQuals["problem_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// fetch reporting region from configuration
+	getCloudGuardConfigurationCached := plugin.HydrateFunc(getCloudGuardConfiguration).WithCache()
+	configuration, err := getCloudGuardConfigurationCached(ctx, d, h)
+	if err != nil {
+		logger.Error("oci_cloud_guard_problem_history.listCloudGuardProblemHistories", "configuration_error", err)
+		return nil, err
+	}
+
+	reportingRegion := configuration.(cloudguard.Configuration).ReportingRegion
+
+	// Create Session
+	session, err := cloudGuardService(ctx, d, *reportingRegion)
+	if err != nil {
+		logger.Error("oci_cloud_guard_problem_history.listCloudGuardProblemHistories", "connection_error", err)
+		return nil, err
+	}
+
+	request := cloudguard.ListProblemHistoriesRequest{
+		CompartmentId: problem.CompartmentId,
+		ProblemId:     problem.Id,
+		Limit:         types.Int(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.CloudGuardClient.ListProblemHistories(ctx, request)
+		if err != nil {
+			logger.Error("oci_cloud_guard_problem_history.listCloudGuardProblemHistories", "api_error", err)
+			return nil, err
+		}
+		for _, history := range response.Items {
+			d.StreamLeafListItem(ctx, cloudGuardProblemHistoryInfo{
+				ProblemHistorySummary: history,
+				ResourceName:          problem.ResourceName,
+				CompartmentId:         problem.CompartmentId,
+			})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
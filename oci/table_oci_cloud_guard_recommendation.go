@@ -0,0 +1,221 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/cloudguard"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableCloudGuardRecommendation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_cloud_guard_recommendation",
+		Description:      "OCI Cloud Guard Recommendation",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			Hydrate: listCloudGuardRecommendations,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "target_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "lifecycle_state",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "lifecycle_detail",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartmentList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the recommendation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique id of the recommendation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the recommendation, e.g. DETECTOR_PROBLEMS or RESOLVED_PROBLEMS.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "risk_level",
+				Description: "The risk level of the problems the recommendation addresses.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "problem_count",
+				Description: "The number of problems the recommendation addresses.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "target_id",
+				Description: "The OCID of the target the recommendation applies to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_state",
+				Description: "The current state of the recommendation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_detail",
+				Description: "The lifecycle detail of the recommendation, e.g. OPEN, RESOLVED or DISMISSED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the recommendation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_created",
+				Description: "The date and time the recommendation was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeCreated.Time"),
+			},
+			{
+				Name:        "time_updated",
+				Description: "The date and time the recommendation was updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeUpdated.Time"),
+			},
+
+			// json fields
+			{
+				Name:        "details",
+				Description: "The details of the recommendation, such as the detector rule it refers to.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudGuardRecommendations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Debug("oci.listCloudGuardRecommendations", "Compartment", compartment)
+
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given compartment_id doesn't match
+	if equalQuals["compartment_id"] != nil && compartment != equalQuals["compartment_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// fetch reporting region from configuration
+	getCloudGuardConfigurationCached := plugin.HydrateFunc(getCloudGuardConfiguration).WithCache()
+	configuration, err := getCloudGuardConfigurationCached(ctx, d, h)
+	if err != nil {
+		logger.Error("oci_cloud_guard_recommendation.listCloudGuardRecommendations", "configuration_error", err)
+		return nil, err
+	}
+
+	reportingRegion := configuration.(cloudguard.Configuration).ReportingRegion
+
+	// Create Session
+	session, err := cloudGuardService(ctx, d, *reportingRegion)
+	if err != nil {
+		logger.Error("oci_cloud_guard_recommendation.listCloudGuardRecommendations", "connection_error", err)
+		return nil, err
+	}
+
+	// Build request parameters
+	request := buildCloudGuardRecommendationFilters(equalQuals)
+	request.CompartmentId = types.String(compartment)
+	request.Limit = types.Int(1000)
+	request.RequestMetadata = common.RequestMetadata{
+		RetryPolicy: getDefaultRetryPolicy(d.Connection),
+	}
+
+	// Check for limit
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < int64(*request.Limit) {
+			request.Limit = types.Int(int(*limit))
+		}
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.CloudGuardClient.ListRecommendations(ctx, request)
+		if err != nil {
+			logger.Error("oci_cloud_guard_recommendation.listCloudGuardRecommendations", "api_error", err)
+			return nil, err
+		}
+		for _, recommendation := range response.Items {
+			d.StreamListItem(ctx, recommendation)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+// Build additional filters
+func buildCloudGuardRecommendationFilters(equalQuals plugin.KeyColumnEqualsQualMap) cloudguard.ListRecommendationsRequest {
+	request := cloudguard.ListRecommendationsRequest{}
+
+	if equalQuals["target_id"] != nil {
+		request.TargetId = types.String(equalQuals["target_id"].GetStringValue())
+	}
+	if equalQuals["lifecycle_state"] != nil {
+		request.LifecycleState = cloudguard.ListRecommendationsLifecycleStateEnum(equalQuals["lifecycle_state"].GetStringValue())
+	}
+	if equalQuals["lifecycle_detail"] != nil {
+		request.LifecycleDetail = cloudguard.ListRecommendationsLifecycleDetailEnum(equalQuals["lifecycle_detail"].GetStringValue())
+	}
+
+	return request
+}
@@ -0,0 +1,325 @@
+package oci
+
+import (
+	"context"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/cloudguard"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableCloudGuardSighting(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_cloud_guard_sighting",
+		Description:      "OCI Cloud Guard Sighting",
+		DefaultTransform: transform.FromCamel(),
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getCloudGuardSighting,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCloudGuardSightings,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "problem_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:      "time_last_detected",
+					Require:   plugin.Optional,
+					Operators: []string{">", ">=", "=", "<", "<="},
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartmentList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The unique id of the sighting.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "sighting_type",
+				Description: "The type of the sighting.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "sighting_type_display_name",
+				Description: "The display name of the sighting type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "problem_id",
+				Description: "The OCID of the problem the sighting is associated with.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "detector_rule_id",
+				Description: "The id of the detector rule that raised the sighting.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "classification_status",
+				Description: "The classification status of the sighting, e.g. TRUE_POSITIVE, FALSE_POSITIVE or NOT_CLASSIFIED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "severity",
+				Description: "The severity of the sighting.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "confidence",
+				Description: "The confidence level of the sighting.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "sighting_score",
+				Description: "The score of the sighting.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "tactic_name",
+				Description: "The MITRE ATT&CK tactic of the sighting.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "technique_name",
+				Description: "The MITRE ATT&CK technique of the sighting.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "actor_principal_id",
+				Description: "The OCID of the principal that performed the activity.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "actor_principal_name",
+				Description: "The name of the principal that performed the activity.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "actor_principal_type",
+				Description: "The type of the principal that performed the activity.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_first_detected",
+				Description: "The date and time the sighting was first detected.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeFirstDetected.Time"),
+			},
+			{
+				Name:        "time_last_detected",
+				Description: "The date and time the sighting was last detected.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeLastDetected.Time"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the sighting.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloudGuardSighting,
+			},
+
+			// json fields
+			{
+				Name:        "regions",
+				Description: "The regions in which the sighting was detected.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "additional_details",
+				Description: "Additional details of the sighting.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudGuardSighting,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SightingTypeDisplayName"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudGuardSightings(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Debug("oci.listCloudGuardSightings", "Compartment", compartment)
+
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given compartment_id doesn't match
+	if equalQuals["compartment_id"] != nil && compartment != equalQuals["compartment_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// fetch reporting region from configuration
+	getCloudGuardConfigurationCached := plugin.HydrateFunc(getCloudGuardConfiguration).WithCache()
+	configuration, err := getCloudGuardConfigurationCached(ctx, d, h)
+	if err != nil {
+		logger.Error("oci_cloud_guard_sighting.listCloudGuardSightings", "configuration_error", err)
+		return nil, err
+	}
+
+	reportingRegion := configuration.(cloudguard.Configuration).ReportingRegion
+
+	// Create Session
+	session, err := cloudGuardService(ctx, d, *reportingRegion)
+	if err != nil {
+		logger.Error("oci_cloud_guard_sighting.listCloudGuardSightings", "connection_error", err)
+		return nil, err
+	}
+
+	// Build request parameters
+	request := buildCloudGuardSightingFilters(d)
+	request.CompartmentId = types.String(compartment)
+	request.Limit = types.Int(1000)
+	request.RequestMetadata = common.RequestMetadata{
+		RetryPolicy: getDefaultRetryPolicy(d.Connection),
+	}
+
+	// Check for limit
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < int64(*request.Limit) {
+			request.Limit = types.Int(int(*limit))
+		}
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.CloudGuardClient.ListSightings(ctx, request)
+		if err != nil {
+			logger.Error("oci_cloud_guard_sighting.listCloudGuardSightings", "api_error", err)
+			return nil, err
+		}
+		for _, sighting := range response.Items {
+			d.StreamListItem(ctx, sighting)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTION
+
+func getCloudGuardSighting(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Debug("oci.getCloudGuardSighting", "Compartment", compartment)
+
+	var id string
+	if h.Item != nil {
+		id = *h.Item.(cloudguard.SightingSummary).Id
+	} else {
+		// Restrict the api call to only root compartment
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+			return nil, nil
+		}
+		id = d.KeyColumnQuals["id"].GetStringValue()
+	}
+
+	// handle empty id in get call
+	if id == "" {
+		return nil, nil
+	}
+
+	// fetch reporting region from configuration
+	getCloudGuardConfigurationCached := plugin.HydrateFunc(getCloudGuardConfiguration).WithCache()
+	configuration, err := getCloudGuardConfigurationCached(ctx, d, h)
+	if err != nil {
+		logger.Error("oci_cloud_guard_sighting.getCloudGuardSighting", "configuration_error", err)
+		return nil, err
+	}
+
+	reportingRegion := configuration.(cloudguard.Configuration).ReportingRegion
+
+	// Create Session
+	session, err := cloudGuardService(ctx, d, *reportingRegion)
+	if err != nil {
+		logger.Error("oci_cloud_guard_sighting.getCloudGuardSighting", "connection_error", err)
+		return nil, err
+	}
+
+	request := cloudguard.GetSightingRequest{
+		SightingId: types.String(id),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.CloudGuardClient.GetSighting(ctx, request)
+	if err != nil {
+		logger.Error("oci_cloud_guard_sighting.getCloudGuardSighting", "api_error", err)
+		return nil, err
+	}
+
+	return response.Sighting, nil
+}
+
+// Build additional filters
+func buildCloudGuardSightingFilters(d *plugin.QueryData) cloudguard.ListSightingsRequest {
+	request := cloudguard.ListSightingsRequest{}
+
+	if d.KeyColumnQuals["problem_id"] != nil {
+		request.ProblemId = types.String(d.KeyColumnQuals["problem_id"].GetStringValue())
+	}
+
+	if d.Quals["time_last_detected"] != nil {
+		for _, q := range d.Quals["time_last_detected"].Quals {
+			timestamp := &common.SDKTime{Time: q.Value.GetTimestampValue().AsTime()}
+			switch q.Operator {
+			case ">", ">=":
+				request.TimeLastDetectedGreaterThanOrEqualTo = timestamp
+			case "<", "<=":
+				request.TimeLastDetectedLessThanOrEqualTo = timestamp
+			case "=":
+				request.TimeLastDetectedGreaterThanOrEqualTo = timestamp
+				request.TimeLastDetectedLessThanOrEqualTo = timestamp
+			}
+		}
+	}
+
+	return request
+}
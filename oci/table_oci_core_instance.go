@@ -48,7 +48,7 @@ func tableCoreInstance(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: compartmentPathColumns([]*plugin.Column{
 			{
 				Name:        "id",
 				Description: "The OCID of the instance.",
@@ -263,7 +263,7 @@ func tableCoreInstance(_ context.Context) *plugin.Table {
 				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
 				Transform:   transform.FromValue(),
 			},
-		},
+		}),
 	}
 }
 
@@ -0,0 +1,199 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableCoreLoadBalancerBackend(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_core_load_balancer_backend",
+		Description:      "OCI Core Load Balancer Backend",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			ParentHydrate: listCoreLoadBalancers,
+			Hydrate:       listCoreLoadBalancerBackends,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "load_balancer_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "backend_set_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "A read-only field showing the IP address and port that uniquely identify this backend server in the backend set.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "backend_set_name",
+				Description: "The name of the backend set the backend server belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "load_balancer_id",
+				Description: "The OCID of the load balancer the backend server belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "ip_address",
+				Description: "The IP address of the backend server.",
+				Type:        proto.ColumnType_IPADDR,
+			},
+			{
+				Name:        "port",
+				Description: "The communication port for the backend server.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "weight",
+				Description: "The load balancing policy weight assigned to the server.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "drain",
+				Description: "Whether the load balancer should drain this server.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "backup",
+				Description: "Whether the load balancer should treat this server as a backup unit.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "offline",
+				Description: "Whether the load balancer should treat this server as offline.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "health_status",
+				Description: "The general health status of the specified backend server.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCoreLoadBalancerBackendHealth,
+				Transform:   transform.FromField("Status"),
+			},
+			{
+				Name:        "health_check_results",
+				Description: "A list of the most recent health check results returned for the specified backend server.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCoreLoadBalancerBackendHealth,
+				Transform:   transform.FromField("HealthCheckResults"),
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LoadBalancerId").Transform(ociRegionName),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+type loadBalancerBackendInfo struct {
+	loadbalancer.Backend
+	BackendSetName *string
+	LoadBalancerId *string
+	CompartmentId  *string
+}
+
+//// LIST FUNCTION
+
+func listCoreLoadBalancerBackends(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	loadBalancer := h.Item.(loadbalancer.LoadBalancer)
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given load_balancer_id doesn't match
+	if equalQuals["load_balancer_id"] != nil && equalQuals["load_balancer_id"].GetStringValue() != types.SafeString(loadBalancer.Id) {
+		return nil, nil
+	}
+
+	for name, backendSet := range loadBalancer.BackendSets {
+		// Skip, if given backend_set_name doesn't match
+		if equalQuals["backend_set_name"] != nil && equalQuals["backend_set_name"].GetStringValue() != name {
+			continue
+		}
+
+		for _, backend := range backendSet.Backends {
+			d.StreamListItem(ctx, loadBalancerBackendInfo{backend, types.String(name), loadBalancer.Id, loadBalancer.CompartmentId})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTION
+
+func getCoreLoadBalancerBackendHealth(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	backend := h.Item.(loadBalancerBackendInfo)
+
+	// Create Session
+	session, err := loadBalancerService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_core_load_balancer_backend.getCoreLoadBalancerBackendHealth", "connection_error", err)
+		return nil, err
+	}
+
+	request := loadbalancer.GetBackendHealthRequest{
+		LoadBalancerId: backend.LoadBalancerId,
+		BackendSetName: backend.BackendSetName,
+		BackendName:    backend.Name,
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.LoadBalancerClient.GetBackendHealth(ctx, request)
+	if err != nil {
+		logger.Error("oci_core_load_balancer_backend.getCoreLoadBalancerBackendHealth", "api_error", err)
+		return nil, err
+	}
+
+	return response.BackendHealth, nil
+}
@@ -0,0 +1,210 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableCoreLoadBalancerBackendSet(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_core_load_balancer_backend_set",
+		Description:      "OCI Core Load Balancer Backend Set",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			ParentHydrate: listCoreLoadBalancers,
+			Hydrate:       listCoreLoadBalancerBackendSets,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "load_balancer_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "A friendly name for the backend set.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "load_balancer_id",
+				Description: "The OCID of the load balancer the backend set belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "load_balancer_name",
+				Description: "The display name of the load balancer the backend set belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "policy",
+				Description: "The load balancer policy for the backend set.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "health_status",
+				Description: "Overall health status of the backend set.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCoreLoadBalancerBackendSetHealth,
+				Transform:   transform.FromField("Status"),
+			},
+			{
+				Name:        "critical_state_backend_names",
+				Description: "A list of backend servers that are currently in the CRITICAL health state.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCoreLoadBalancerBackendSetHealth,
+				Transform:   transform.FromField("CriticalStateBackendNames"),
+			},
+			{
+				Name:        "warning_state_backend_names",
+				Description: "A list of backend servers that are currently in the WARNING health state.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCoreLoadBalancerBackendSetHealth,
+				Transform:   transform.FromField("WarningStateBackendNames"),
+			},
+			{
+				Name:        "unknown_state_backend_names",
+				Description: "A list of backend servers that are currently in the UNKNOWN health state.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCoreLoadBalancerBackendSetHealth,
+				Transform:   transform.FromField("UnknownStateBackendNames"),
+			},
+			{
+				Name:        "backends",
+				Description: "The backend servers of the backend set.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "health_checker",
+				Description: "The health check policy configuration.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "ssl_configuration",
+				Description: "The load balancer's SSL handling configuration details.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "session_persistence_configuration",
+				Description: "The configuration details for implementing session persistence based on a user-specified cookie name.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "lb_cookie_session_persistence_configuration",
+				Description: "The configuration details for implementing load balancer cookie session persistence.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LoadBalancerId").Transform(ociRegionName),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+type loadBalancerBackendSetInfo struct {
+	loadbalancer.BackendSet
+	LoadBalancerId   *string
+	LoadBalancerName *string
+	CompartmentId    *string
+}
+
+//// LIST FUNCTION
+
+func listCoreLoadBalancerBackendSets(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	loadBalancer := h.Item.(loadbalancer.LoadBalancer)
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given load_balancer_id doesn't match
+	if equalQuals["load_balancer_id"] != nil && equalQuals["load_balancer_id"].GetStringValue() != types.SafeString(loadBalancer.Id) {
+		return nil, nil
+	}
+
+	for name, backendSet := range loadBalancer.BackendSets {
+		// Skip, if given name doesn't match
+		if equalQuals["name"] != nil && equalQuals["name"].GetStringValue() != name {
+			continue
+		}
+
+		d.StreamListItem(ctx, loadBalancerBackendSetInfo{backendSet, loadBalancer.Id, loadBalancer.DisplayName, loadBalancer.CompartmentId})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTION
+
+func getCoreLoadBalancerBackendSetHealth(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	backendSet := h.Item.(loadBalancerBackendSetInfo)
+
+	// Create Session
+	session, err := loadBalancerService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_core_load_balancer_backend_set.getCoreLoadBalancerBackendSetHealth", "connection_error", err)
+		return nil, err
+	}
+
+	request := loadbalancer.GetBackendSetHealthRequest{
+		LoadBalancerId: backendSet.LoadBalancerId,
+		BackendSetName: backendSet.Name,
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.LoadBalancerClient.GetBackendSetHealth(ctx, request)
+	if err != nil {
+		logger.Error("oci_core_load_balancer_backend_set.getCoreLoadBalancerBackendSetHealth", "api_error", err)
+		return nil, err
+	}
+
+	return response.BackendSetHealth, nil
+}
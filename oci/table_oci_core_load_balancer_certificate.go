@@ -0,0 +1,148 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableCoreLoadBalancerCertificate(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_core_load_balancer_certificate",
+		Description:      "OCI Core Load Balancer Certificate",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			ParentHydrate: listCoreLoadBalancers,
+			Hydrate:       listCoreLoadBalancerCertificates,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "load_balancer_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "certificate_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: CertificateColumns([]*plugin.Column{
+			{
+				Name:        "certificate_name",
+				Description: "A friendly name for the certificate bundle.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "load_balancer_id",
+				Description: "The OCID of the load balancer the certificate bundle belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "load_balancer_name",
+				Description: "The display name of the load balancer the certificate bundle belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "public_certificate",
+				Description: "The public certificate, in PEM format.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "ca_certificate",
+				Description: "The Certificate Authority certificate, or any interim certificate, in PEM format.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CertificateName"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LoadBalancerId").Transform(ociRegionName),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+type loadBalancerCertificateInfo struct {
+	loadbalancer.Certificate
+	LoadBalancerId        *string
+	LoadBalancerName      *string
+	CompartmentId         *string
+	CertificateDetails    *certificateDetails
+	CertificateParseError *string
+}
+
+//// LIST FUNCTION
+
+func listCoreLoadBalancerCertificates(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	loadBalancer := h.Item.(loadbalancer.LoadBalancer)
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given load_balancer_id doesn't match
+	if equalQuals["load_balancer_id"] != nil && equalQuals["load_balancer_id"].GetStringValue() != types.SafeString(loadBalancer.Id) {
+		return nil, nil
+	}
+
+	for name, certificate := range loadBalancer.Certificates {
+		// Skip, if given certificate_name doesn't match
+		if equalQuals["certificate_name"] != nil && equalQuals["certificate_name"].GetStringValue() != name {
+			continue
+		}
+
+		item := loadBalancerCertificateInfo{
+			Certificate:      certificate,
+			LoadBalancerId:   loadBalancer.Id,
+			LoadBalancerName: loadBalancer.DisplayName,
+			CompartmentId:    loadBalancer.CompartmentId,
+		}
+
+		// A certificate that cannot be parsed is still listed, with the error recorded
+		details, err := parsePEMCertificate(types.SafeString(certificate.PublicCertificate))
+		if err != nil {
+			plugin.Logger(ctx).Debug("oci_core_load_balancer_certificate.listCoreLoadBalancerCertificates", "parse_error", err, "certificate_name", name)
+			item.CertificateParseError = types.String(err.Error())
+		} else {
+			item.CertificateDetails = details
+		}
+
+		d.StreamListItem(ctx, item)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,199 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableCoreLoadBalancerHealth(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_core_load_balancer_health",
+		Description:      "OCI Core Load Balancer Health",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			Hydrate: listCoreLoadBalancerHealths,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "load_balancer_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "status",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "load_balancer_id",
+				Description: "The OCID of the load balancer the health status is associated with.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The overall health status of the load balancer.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "total_backend_set_count",
+				Description: "The total number of backend sets associated with this load balancer.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getCoreLoadBalancerHealth,
+			},
+			{
+				Name:        "critical_state_backend_set_names",
+				Description: "A list of backend sets that are currently in the CRITICAL health state.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCoreLoadBalancerHealth,
+			},
+			{
+				Name:        "warning_state_backend_set_names",
+				Description: "A list of backend sets that are currently in the WARNING health state.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCoreLoadBalancerHealth,
+			},
+			{
+				Name:        "unknown_state_backend_set_names",
+				Description: "A list of backend sets that are currently in the UNKNOWN health state.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCoreLoadBalancerHealth,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LoadBalancerId"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LoadBalancerId").Transform(ociRegionName),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromMatrixItem(matrixKeyCompartment),
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listCoreLoadBalancerHealths(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Debug("oci_core_load_balancer_health.listCoreLoadBalancerHealths", "Compartment", compartment, "OCI_REGION", region)
+
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given compartment_id doesn't match
+	if equalQuals["compartment_id"] != nil && compartment != equalQuals["compartment_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := loadBalancerService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_core_load_balancer_health.listCoreLoadBalancerHealths", "connection_error", err)
+		return nil, err
+	}
+
+	request := loadbalancer.ListLoadBalancerHealthsRequest{
+		CompartmentId: types.String(compartment),
+		Limit:         types.Int64(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.LoadBalancerClient.ListLoadBalancerHealths(ctx, request)
+		if err != nil {
+			logger.Error("oci_core_load_balancer_health.listCoreLoadBalancerHealths", "api_error", err)
+			return nil, err
+		}
+
+		for _, health := range response.Items {
+			// Skip, if given load_balancer_id or status doesn't match
+			if equalQuals["load_balancer_id"] != nil && equalQuals["load_balancer_id"].GetStringValue() != types.SafeString(health.LoadBalancerId) {
+				continue
+			}
+			if equalQuals["status"] != nil && equalQuals["status"].GetStringValue() != string(health.Status) {
+				continue
+			}
+
+			d.StreamListItem(ctx, health)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTION
+
+func getCoreLoadBalancerHealth(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	health := h.Item.(loadbalancer.LoadBalancerHealthSummary)
+
+	// Create Session
+	session, err := loadBalancerService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_core_load_balancer_health.getCoreLoadBalancerHealth", "connection_error", err)
+		return nil, err
+	}
+
+	request := loadbalancer.GetLoadBalancerHealthRequest{
+		LoadBalancerId: health.LoadBalancerId,
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.LoadBalancerClient.GetLoadBalancerHealth(ctx, request)
+	if err != nil {
+		logger.Error("oci_core_load_balancer_health.getCoreLoadBalancerHealth", "api_error", err)
+		return nil, err
+	}
+
+	return response.LoadBalancerHealth, nil
+}
@@ -0,0 +1,189 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableCoreLoadBalancerListener(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_core_load_balancer_listener",
+		Description:      "OCI Core Load Balancer Listener",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			ParentHydrate: listCoreLoadBalancers,
+			Hydrate:       listCoreLoadBalancerListeners,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "load_balancer_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "A friendly name for the listener.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "load_balancer_id",
+				Description: "The OCID of the load balancer the listener belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "load_balancer_name",
+				Description: "The display name of the load balancer the listener belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "protocol",
+				Description: "The protocol on which the listener accepts connection requests.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "port",
+				Description: "The communication port for the listener.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "default_backend_set_name",
+				Description: "The name of the associated backend set.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "certificate_name",
+				Description: "A friendly name for the certificate bundle used by the listener, if SSL is enabled.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SslConfiguration.CertificateName"),
+			},
+			{
+				Name:        "cipher_suite_name",
+				Description: "The name of the cipher suite used by the listener, if SSL is enabled.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SslConfiguration.CipherSuiteName"),
+			},
+			{
+				Name:        "ssl_protocols",
+				Description: "A list of SSL protocols the listener supports, if SSL is enabled.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SslConfiguration.Protocols"),
+			},
+			{
+				Name:        "idle_timeout",
+				Description: "The maximum idle time, in seconds, allowed between two successive receive or two successive send operations between the client and backend servers.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ConnectionConfiguration.IdleTimeout"),
+			},
+			{
+				Name:        "path_route_set_name",
+				Description: "The name of the set of path-based routing rules applied to this listener's traffic.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "routing_policy_name",
+				Description: "The name of the routing policy applied to this listener's traffic.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "hostname_names",
+				Description: "An array of hostname resource names.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "rule_set_names",
+				Description: "The names of the rule sets to apply to the listener.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "ssl_configuration",
+				Description: "The listener's SSL handling configuration details.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "connection_configuration",
+				Description: "Configuration details for the connection between the client and backend servers.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LoadBalancerId").Transform(ociRegionName),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+type loadBalancerListenerInfo struct {
+	loadbalancer.Listener
+	LoadBalancerId   *string
+	LoadBalancerName *string
+	CompartmentId    *string
+}
+
+//// LIST FUNCTION
+
+func listCoreLoadBalancerListeners(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	loadBalancer := h.Item.(loadbalancer.LoadBalancer)
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given load_balancer_id doesn't match
+	if equalQuals["load_balancer_id"] != nil && equalQuals["load_balancer_id"].GetStringValue() != types.SafeString(loadBalancer.Id) {
+		return nil, nil
+	}
+
+	for name, listener := range loadBalancer.Listeners {
+		// Skip, if given name doesn't match
+		if equalQuals["name"] != nil && equalQuals["name"].GetStringValue() != name {
+			continue
+		}
+
+		d.StreamListItem(ctx, loadBalancerListenerInfo{listener, loadBalancer.Id, loadBalancer.DisplayName, loadBalancer.CompartmentId})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -290,6 +290,8 @@ func getCoreNetworkLoadBalancerHealth(ctx context.Context, d *plugin.QueryData,
 		id = *h.Item.(networkloadbalancer.NetworkLoadBalancerSummary).Id
 	case networkloadbalancer.NetworkLoadBalancer:
 		id = *h.Item.(networkloadbalancer.NetworkLoadBalancer).Id
+	case networkloadbalancer.NetworkLoadBalancerHealthSummary:
+		id = *h.Item.(networkloadbalancer.NetworkLoadBalancerHealthSummary).NetworkLoadBalancerId
 	}
 
 	// Create Session
@@ -0,0 +1,204 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableCoreNetworkLoadBalancerBackend(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_core_network_load_balancer_backend",
+		Description:      "OCI Core Network Load Balancer Backend",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			ParentHydrate: listCoreNetworkLoadBalancers,
+			Hydrate:       listCoreNetworkLoadBalancerBackends,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "network_load_balancer_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "backend_set_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "A read-only field showing the IP address and port that uniquely identify this backend server in the backend set.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "backend_set_name",
+				Description: "The name of the backend set the backend server belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "network_load_balancer_id",
+				Description: "The OCID of the network load balancer the backend server belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "ip_address",
+				Description: "The IP address of the backend server.",
+				Type:        proto.ColumnType_IPADDR,
+			},
+			{
+				Name:        "port",
+				Description: "The communication port for the backend server.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "weight",
+				Description: "The load balancing policy weight assigned to the server.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "target_id",
+				Description: "The IP OCID/Instance OCID associated with the backend server.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_drain",
+				Description: "Whether the network load balancer should drain this server.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "is_backup",
+				Description: "Whether the network load balancer should treat this server as a backup unit.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "is_offline",
+				Description: "Whether the network load balancer should treat this server as offline.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "health_status",
+				Description: "The general health status of the specified backend server.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCoreNetworkLoadBalancerBackendHealth,
+				Transform:   transform.FromField("Status"),
+			},
+			{
+				Name:        "health_check_results",
+				Description: "A list of the most recent health check results returned for the specified backend server.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCoreNetworkLoadBalancerBackendHealth,
+				Transform:   transform.FromField("HealthCheckResults"),
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NetworkLoadBalancerId").Transform(ociRegionName),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+type networkLoadBalancerBackendInfo struct {
+	networkloadbalancer.Backend
+	BackendSetName        *string
+	NetworkLoadBalancerId *string
+	CompartmentId         *string
+}
+
+//// LIST FUNCTION
+
+func listCoreNetworkLoadBalancerBackends(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	networkLoadBalancer := h.Item.(networkloadbalancer.NetworkLoadBalancerSummary)
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given network_load_balancer_id doesn't match
+	if equalQuals["network_load_balancer_id"] != nil && equalQuals["network_load_balancer_id"].GetStringValue() != types.SafeString(networkLoadBalancer.Id) {
+		return nil, nil
+	}
+
+	for name, backendSet := range networkLoadBalancer.BackendSets {
+		// Skip, if given backend_set_name doesn't match
+		if equalQuals["backend_set_name"] != nil && equalQuals["backend_set_name"].GetStringValue() != name {
+			continue
+		}
+
+		for _, backend := range backendSet.Backends {
+			d.StreamListItem(ctx, networkLoadBalancerBackendInfo{backend, types.String(name), networkLoadBalancer.Id, networkLoadBalancer.CompartmentId})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTION
+
+func getCoreNetworkLoadBalancerBackendHealth(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	backend := h.Item.(networkLoadBalancerBackendInfo)
+
+	// Create Session
+	session, err := networkLoadBalancerService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_core_network_load_balancer_backend.getCoreNetworkLoadBalancerBackendHealth", "connection_error", err)
+		return nil, err
+	}
+
+	request := networkloadbalancer.GetBackendHealthRequest{
+		NetworkLoadBalancerId: backend.NetworkLoadBalancerId,
+		BackendSetName:        backend.BackendSetName,
+		BackendName:           backend.Name,
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.NetworkLoadBalancerClient.GetBackendHealth(ctx, request)
+	if err != nil {
+		logger.Error("oci_core_network_load_balancer_backend.getCoreNetworkLoadBalancerBackendHealth", "api_error", err)
+		return nil, err
+	}
+
+	return response.BackendHealth, nil
+}
@@ -0,0 +1,205 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableCoreNetworkLoadBalancerBackendSet(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_core_network_load_balancer_backend_set",
+		Description:      "OCI Core Network Load Balancer Backend Set",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			ParentHydrate: listCoreNetworkLoadBalancers,
+			Hydrate:       listCoreNetworkLoadBalancerBackendSets,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "network_load_balancer_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "A friendly name for the backend set.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "network_load_balancer_id",
+				Description: "The OCID of the network load balancer the backend set belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "network_load_balancer_name",
+				Description: "The display name of the network load balancer the backend set belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "policy",
+				Description: "The network load balancer policy for the backend set.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "health_status",
+				Description: "Overall health status of the backend set.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCoreNetworkLoadBalancerBackendSetHealth,
+				Transform:   transform.FromField("Status"),
+			},
+			{
+				Name:        "critical_state_backend_names",
+				Description: "A list of backend servers that are currently in the CRITICAL health state.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCoreNetworkLoadBalancerBackendSetHealth,
+				Transform:   transform.FromField("CriticalStateBackendNames"),
+			},
+			{
+				Name:        "warning_state_backend_names",
+				Description: "A list of backend servers that are currently in the WARNING health state.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCoreNetworkLoadBalancerBackendSetHealth,
+				Transform:   transform.FromField("WarningStateBackendNames"),
+			},
+			{
+				Name:        "unknown_state_backend_names",
+				Description: "A list of backend servers that are currently in the UNKNOWN health state.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCoreNetworkLoadBalancerBackendSetHealth,
+				Transform:   transform.FromField("UnknownStateBackendNames"),
+			},
+			{
+				Name:        "is_preserve_source",
+				Description: "If this parameter is enabled, then the network load balancer preserves the source IP of the packet when it is forwarded to backends.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "ip_version",
+				Description: "IP version associated with the backend set.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "backends",
+				Description: "The backend servers of the backend set.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "health_checker",
+				Description: "The health check policy configuration.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NetworkLoadBalancerId").Transform(ociRegionName),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+type networkLoadBalancerBackendSetInfo struct {
+	networkloadbalancer.BackendSet
+	NetworkLoadBalancerId   *string
+	NetworkLoadBalancerName *string
+	CompartmentId           *string
+}
+
+//// LIST FUNCTION
+
+func listCoreNetworkLoadBalancerBackendSets(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	networkLoadBalancer := h.Item.(networkloadbalancer.NetworkLoadBalancerSummary)
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given network_load_balancer_id doesn't match
+	if equalQuals["network_load_balancer_id"] != nil && equalQuals["network_load_balancer_id"].GetStringValue() != types.SafeString(networkLoadBalancer.Id) {
+		return nil, nil
+	}
+
+	for name, backendSet := range networkLoadBalancer.BackendSets {
+		// Skip, if given name doesn't match
+		if equalQuals["name"] != nil && equalQuals["name"].GetStringValue() != name {
+			continue
+		}
+
+		d.StreamListItem(ctx, networkLoadBalancerBackendSetInfo{backendSet, networkLoadBalancer.Id, networkLoadBalancer.DisplayName, networkLoadBalancer.CompartmentId})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTION
+
+func getCoreNetworkLoadBalancerBackendSetHealth(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	backendSet := h.Item.(networkLoadBalancerBackendSetInfo)
+
+	// Create Session
+	session, err := networkLoadBalancerService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_core_network_load_balancer_backend_set.getCoreNetworkLoadBalancerBackendSetHealth", "connection_error", err)
+		return nil, err
+	}
+
+	request := networkloadbalancer.GetBackendSetHealthRequest{
+		NetworkLoadBalancerId: backendSet.NetworkLoadBalancerId,
+		BackendSetName:        backendSet.Name,
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.NetworkLoadBalancerClient.GetBackendSetHealth(ctx, request)
+	if err != nil {
+		logger.Error("oci_core_network_load_balancer_backend_set.getCoreNetworkLoadBalancerBackendSetHealth", "api_error", err)
+		return nil, err
+	}
+
+	return response.BackendSetHealth, nil
+}
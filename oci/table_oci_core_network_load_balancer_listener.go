@@ -0,0 +1,140 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableCoreNetworkLoadBalancerListener(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_core_network_load_balancer_listener",
+		Description:      "OCI Core Network Load Balancer Listener",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			ParentHydrate: listCoreNetworkLoadBalancers,
+			Hydrate:       listCoreNetworkLoadBalancerListeners,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "network_load_balancer_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "A friendly name for the listener.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "network_load_balancer_id",
+				Description: "The OCID of the network load balancer the listener belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "network_load_balancer_name",
+				Description: "The display name of the network load balancer the listener belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "protocol",
+				Description: "The protocol on which the listener accepts connection requests.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "port",
+				Description: "The communication port for the listener.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "default_backend_set_name",
+				Description: "The name of the associated backend set.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "ip_version",
+				Description: "IP version associated with the listener.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NetworkLoadBalancerId").Transform(ociRegionName),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+type networkLoadBalancerListenerInfo struct {
+	networkloadbalancer.Listener
+	NetworkLoadBalancerId   *string
+	NetworkLoadBalancerName *string
+	CompartmentId           *string
+}
+
+//// LIST FUNCTION
+
+func listCoreNetworkLoadBalancerListeners(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	networkLoadBalancer := h.Item.(networkloadbalancer.NetworkLoadBalancerSummary)
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given network_load_balancer_id doesn't match
+	if equalQuals["network_load_balancer_id"] != nil && equalQuals["network_load_balancer_id"].GetStringValue() != types.SafeString(networkLoadBalancer.Id) {
+		return nil, nil
+	}
+
+	for name, listener := range networkLoadBalancer.Listeners {
+		// Skip, if given name doesn't match
+		if equalQuals["name"] != nil && equalQuals["name"].GetStringValue() != name {
+			continue
+		}
+
+		d.StreamListItem(ctx, networkLoadBalancerListenerInfo{listener, networkLoadBalancer.Id, networkLoadBalancer.DisplayName, networkLoadBalancer.CompartmentId})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,513 @@
+package oci
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/objectstorage"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// Cost reports are written to a bucket named after the tenancy OCID, in the
+// Oracle owned bling namespace of the home region
+const (
+	costReportNamespace = "bling"
+	costReportPrefix    = "reports/cost-csv/"
+)
+
+// Downloaded reports are cached on disk. Reports not read for
+// costReportCacheMaxAge are deleted, as are the least recently read reports
+// once the cache grows beyond costReportCacheMaxBytes.
+const (
+	costReportCacheMaxAge   = 7 * 24 * time.Hour
+	costReportCacheMaxBytes = 512 * 1024 * 1024
+)
+
+//// TABLE DEFINITION
+
+func tableCostReportLineItem(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_cost_report_line_item",
+		Description:      "OCI Cost Report Line Item",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			Hydrate: listCostReportLineItems,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:      "interval_usage_start",
+					Require:   plugin.Optional,
+					Operators: []string{">", ">=", "=", "<", "<="},
+				},
+				{
+					Name:    "report_name",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "report_name",
+				Description: "The name of the cost report object the line item was read from.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "report_line_number",
+				Description: "The line number of the line item in the cost report.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "reference_no",
+				Description: "The reference number of the line item, used to match corrections to the original line item.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "interval_usage_start",
+				Description: "The start time of the usage interval for the resource.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "interval_usage_end",
+				Description: "The end time of the usage interval for the resource.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "service",
+				Description: "The service the resource is in.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource",
+				Description: "The resource name used by the metering system.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "compartment_name",
+				Description: "The name of the compartment the resource is in.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "availability_domain",
+				Description: "The availability domain the resource is in.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_id",
+				Description: "The OCID of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "billed_quantity",
+				Description: "The quantity of the resource that has been billed over the usage interval.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "billed_quantity_overage",
+				Description: "The quantity of the resource that has been billed as overage over the usage interval.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "subscription_id",
+				Description: "The ID of the subscription the cost is billed to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "product_sku",
+				Description: "The part number of the SKU.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the SKU.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "unit_price",
+				Description: "The cost per unit of usage.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "unit_price_overage",
+				Description: "The cost per unit of overage usage.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "my_cost",
+				Description: "The cost charged for the usage.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "my_cost_overage",
+				Description: "The cost charged for the overage usage.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "currency_code",
+				Description: "The currency code of the cost.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "billing_unit_readable",
+				Description: "The readable billing unit of the SKU.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "sku_unit_description",
+				Description: "The unit the SKU is billed in.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "overage_flag",
+				Description: "Whether the usage is overage usage.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_correction",
+				Description: "True if the line item is a correction of a previously reported line item.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "backreference_no",
+				Description: "The reference number of the line item the correction applies to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "parse_error",
+				Description: "The reason the line of the cost report could not be parsed. Columns that could not be parsed are null.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ReferenceNo"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listCostReportLineItems(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	// Cost reports are only available in the home region
+	region, err := getHomeRegion(ctx, d)
+	if err != nil {
+		logger.Error("oci_cost_report_line_item.listCostReportLineItems", "api_error", err)
+		return nil, err
+	}
+
+	// Create Session
+	session, err := objectStorageService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_cost_report_line_item.listCostReportLineItems", "connection_error", err)
+		return nil, err
+	}
+
+	// A report only holds usage from before it was created, so reports created
+	// before the start of the requested interval can be skipped
+	var after, before *time.Time
+	if d.Quals["interval_usage_start"] != nil {
+		for _, q := range d.Quals["interval_usage_start"].Quals {
+			t := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case ">", ">=":
+				after = &t
+			case "<", "<=":
+				before = &t
+			case "=":
+				after, before = &t, &t
+			}
+		}
+	}
+
+	request := objectstorage.ListObjectsRequest{
+		NamespaceName: types.String(costReportNamespace),
+		BucketName:    types.String(session.TenancyID),
+		Prefix:        types.String(costReportPrefix),
+		Fields:        types.String("name,etag,timeCreated"),
+		Limit:         types.Int(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+	if d.KeyColumnQuals["report_name"] != nil {
+		request.Prefix = types.String(d.KeyColumnQuals["report_name"].GetStringValue())
+	}
+
+	for {
+		response, err := session.ObjectStorageClient.ListObjects(ctx, request)
+		if err != nil {
+			logger.Error("oci_cost_report_line_item.listCostReportLineItems", "api_error", err)
+			return nil, err
+		}
+
+		for _, object := range response.Objects {
+			if after != nil && object.TimeCreated != nil && object.TimeCreated.Time.Before(*after) {
+				continue
+			}
+
+			done := false
+			err := streamCostReport(ctx, d, session, object, func(item costReportLineItem) bool {
+				if item.IntervalUsageStart != nil {
+					if after != nil && item.IntervalUsageStart.Before(*after) {
+						return true
+					}
+					if before != nil && item.IntervalUsageStart.After(*before) {
+						return true
+					}
+				}
+				if item.ParseError != nil {
+					logger.Warn("oci_cost_report_line_item.listCostReportLineItems", "parse_error", *item.ParseError, "report", *object.Name, "line", item.ReportLineNumber)
+				}
+				d.StreamListItem(ctx, item)
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				done = d.QueryStatus.RowsRemaining(ctx) == 0
+				return !done
+			})
+			if err != nil {
+				logger.Error("oci_cost_report_line_item.listCostReportLineItems", "report_error", err, "report", *object.Name)
+				return nil, err
+			}
+			if done {
+				return nil, nil
+			}
+		}
+
+		if response.NextStartWith == nil {
+			break
+		}
+		request.Start = response.NextStartWith
+	}
+
+	return nil, nil
+}
+
+// streamCostReport parses a cost report, reading it from the local report
+// cache when a copy with the same etag has already been downloaded. A cached
+// copy that cannot be read, e.g. because it is truncated, is deleted and the
+// report downloaded again, resuming after the lines already streamed.
+func streamCostReport(ctx context.Context, d *plugin.QueryData, session *session, object objectstorage.ObjectSummary, fn func(costReportLineItem) bool) error {
+	streamed := 0
+	for {
+		path, cached, err := getCachedCostReport(ctx, d, session, object)
+		if err != nil {
+			return err
+		}
+
+		err = parseCostReportFile(path, func(item costReportLineItem) bool {
+			if item.ReportLineNumber <= streamed {
+				return true
+			}
+			streamed = item.ReportLineNumber
+			item.ReportName = *object.Name
+			return fn(item)
+		})
+		if err == nil {
+			return nil
+		}
+
+		if removeErr := os.Remove(path); removeErr != nil && !os.IsNotExist(removeErr) {
+			plugin.Logger(ctx).Warn("oci_cost_report_line_item.streamCostReport", "cache_error", removeErr)
+		}
+		if !cached {
+			return err
+		}
+		plugin.Logger(ctx).Warn("oci_cost_report_line_item.streamCostReport", "corrupt_cache", err, "report", *object.Name)
+	}
+}
+
+func parseCostReportFile(path string, fn func(costReportLineItem) bool) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return parseCostReport(file, fn)
+}
+
+var costReportCacheNameUnsafe = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+
+// costReportCacheName returns the name of the local copy of a report. Reports
+// of different tenancies share the cache, so the name includes the tenancy.
+func costReportCacheName(tenancyId string, object objectstorage.ObjectSummary) string {
+	name := fmt.Sprintf("%s-%s-%s", tenancyId, filepath.Base(types.SafeString(object.Name)), types.SafeString(object.Etag))
+	return costReportCacheNameUnsafe.ReplaceAllString(name, "_")
+}
+
+// costReportCacheDir returns the directory of the local report cache. It is
+// created in the user's cache directory, which unlike the temporary directory
+// is not shared with other users, and is only accessible by its owner.
+func costReportCacheDir() (string, error) {
+	base, err := os.UserCacheDir()
+	if err != nil {
+		base = os.TempDir()
+	}
+	dir := filepath.Join(base, "steampipe-plugin-oci", "cost-reports")
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", err
+	}
+	// MkdirAll leaves the permissions of an existing directory unchanged
+	if err := os.Chmod(dir, 0700); err != nil {
+		return "", err
+	}
+
+	return dir, nil
+}
+
+// getCachedCostReport returns the path of the local copy of a cost report,
+// downloading the report if no copy with the same etag exists, and whether the
+// copy was already cached
+func getCachedCostReport(ctx context.Context, d *plugin.QueryData, session *session, object objectstorage.ObjectSummary) (string, bool, error) {
+	dir, err := costReportCacheDir()
+	if err != nil {
+		return "", false, err
+	}
+	path := filepath.Join(dir, costReportCacheName(session.TenancyID, object)+".csv.gz")
+	if _, err := os.Stat(path); err == nil {
+		// mark the report as recently read, so it is evicted last
+		now := time.Now()
+		if err := os.Chtimes(path, now, now); err != nil {
+			plugin.Logger(ctx).Warn("oci_cost_report_line_item.getCachedCostReport", "cache_error", err)
+		}
+		return path, true, nil
+	}
+
+	request := objectstorage.GetObjectRequest{
+		NamespaceName: types.String(costReportNamespace),
+		BucketName:    types.String(session.TenancyID),
+		ObjectName:    object.Name,
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+	response, err := session.ObjectStorageClient.GetObject(ctx, request)
+	if err != nil {
+		return "", false, err
+	}
+	defer response.Content.Close()
+
+	// write to a temporary file first, so an interrupted download is never
+	// mistaken for a cached report
+	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return "", false, err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, response.Content); err != nil {
+		tmp.Close()
+		return "", false, err
+	}
+	if err := tmp.Close(); err != nil {
+		return "", false, err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", false, err
+	}
+
+	if err := pruneCostReportCache(dir, path, time.Now()); err != nil {
+		plugin.Logger(ctx).Warn("oci_cost_report_line_item.getCachedCostReport", "cache_error", err)
+	}
+
+	return path, false, nil
+}
+
+// pruneCostReportCache deletes the cached reports, and leftover temporary
+// files, not read for costReportCacheMaxAge, then the least recently read
+// reports until the cache fits in costReportCacheMaxBytes. The report at keep
+// is never deleted.
+func pruneCostReportCache(dir string, keep string, now time.Time) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	type cachedReport struct {
+		path    string
+		size    int64
+		modTime time.Time
+	}
+	var reports []cachedReport
+	var total int64
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		if path != keep && now.Sub(info.ModTime()) > costReportCacheMaxAge {
+			if err := os.Remove(path); err != nil {
+				return err
+			}
+			continue
+		}
+		// files being downloaded are left alone
+		if !strings.HasSuffix(path, ".csv.gz") {
+			continue
+		}
+		reports = append(reports, cachedReport{path, info.Size(), info.ModTime()})
+		total += info.Size()
+	}
+
+	sort.Slice(reports, func(i, j int) bool {
+		return reports[i].modTime.Before(reports[j].modTime)
+	})
+	for _, report := range reports {
+		if total <= costReportCacheMaxBytes {
+			break
+		}
+		if report.path == keep {
+			continue
+		}
+		if err := os.Remove(report.path); err != nil {
+			return err
+		}
+		total -= report.size
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package oci
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/objectstorage"
+	"github.com/turbot/go-kit/types"
+)
+
+func TestPruneCostReportCache(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+
+	// name, size and age of each cached file
+	files := []struct {
+		name string
+		size int
+		age  time.Duration
+	}{
+		{"expired.csv.gz", 10, costReportCacheMaxAge + time.Hour},
+		{"expired.csv.gz.123.tmp", 10, costReportCacheMaxAge + time.Hour},
+		{"downloading.csv.gz.456.tmp", costReportCacheMaxBytes, time.Minute},
+		{"oldest.csv.gz", costReportCacheMaxBytes / 2, 3 * time.Hour},
+		{"older.csv.gz", costReportCacheMaxBytes / 2, 2 * time.Hour},
+		{"recent.csv.gz", costReportCacheMaxBytes / 4, time.Hour},
+		{"new.csv.gz", costReportCacheMaxBytes / 4, 4 * time.Hour},
+	}
+	for _, file := range files {
+		path := filepath.Join(dir, file.name)
+		if err := os.WriteFile(path, nil, 0600); err != nil {
+			t.Fatal(err)
+		}
+		// sparse files keep the test fast
+		if err := os.Truncate(path, int64(file.size)); err != nil {
+			t.Fatal(err)
+		}
+		modTime := now.Add(-file.age)
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := pruneCostReportCache(dir, filepath.Join(dir, "new.csv.gz"), now); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	sort.Strings(names)
+
+	// the oldest report is evicted to fit the size limit, the report being
+	// kept is not, even though it is older
+	expected := "downloading.csv.gz.456.tmp,new.csv.gz,older.csv.gz,recent.csv.gz"
+	if strings.Join(names, ",") != expected {
+		t.Errorf("expected %s, got %s", expected, strings.Join(names, ","))
+	}
+}
+
+func TestCostReportCacheName(t *testing.T) {
+	object := objectstorage.ObjectSummary{
+		Name: types.String("reports/cost-csv/0001000000123456.csv.gz"),
+		Etag: types.String("a1b2c3/d4"),
+	}
+
+	name := costReportCacheName("ocid1.tenancy.oc1..aaaa", object)
+	if name != "ocid1_tenancy_oc1_aaaa-0001000000123456_csv_gz-a1b2c3_d4" {
+		t.Errorf("unexpected cache name %s", name)
+	}
+
+	// reports with the same name and etag in another tenancy don't collide
+	if other := costReportCacheName("ocid1.tenancy.oc1..bbbb", object); other == name {
+		t.Errorf("expected different cache names for different tenancies, got %s", other)
+	}
+}
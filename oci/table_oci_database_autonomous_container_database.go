@@ -0,0 +1,437 @@
+package oci
+
+import (
+	"context"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/database"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableOciDatabaseAutonomousContainerDatabase(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_database_autonomous_container_database",
+		Description:      "OCI Database Autonomous Container Database",
+		DefaultTransform: transform.FromCamel(),
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getAutonomousContainerDatabase,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAutonomousContainerDatabases,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "autonomous_exadata_infrastructure_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "autonomous_vm_cluster_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "cloud_autonomous_vm_cluster_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "display_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "infrastructure_type",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "lifecycle_state",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "display_name",
+				Description: "The user-provided name for the autonomous container database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The OCID of the autonomous container database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_state",
+				Description: "The current state of the autonomous container database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_created",
+				Description: "The date and time the autonomous container database was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeCreated.Time"),
+			},
+			{
+				Name:        "autonomous_exadata_infrastructure_id",
+				Description: "The OCID of the autonomous exadata infrastructure.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "autonomous_vm_cluster_id",
+				Description: "The OCID of the autonomous VM cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "availability_domain",
+				Description: "The availability domain of the autonomous container database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "available_cpus",
+				Description: "Sum of OCPUs available on the autonomous VM cluster for this autonomous container database.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "cloud_autonomous_vm_cluster_id",
+				Description: "The OCID of the cloud autonomous exadata VM cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "db_unique_name",
+				Description: "The database name for the autonomous container database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "db_version",
+				Description: "Oracle Database version of the autonomous container database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "infrastructure_type",
+				Description: "The infrastructure type this resource belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "key_store_id",
+				Description: "The OCID of the key store.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "key_store_wallet_name",
+				Description: "The wallet name for Oracle Key Vault.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kms_key_id",
+				Description: "The OCID of the key container that is used as the master encryption key in database transparent data encryption (TDE) operations.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kms_key_version_id",
+				Description: "The OCID of the key container version that is used in database transparent data encryption (TDE) operations KMS Key can have multiple key versions.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_maintenance_run_id",
+				Description: "The OCID of the last maintenance run.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_details",
+				Description: "Additional information about the current lifecycle state.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "memory_per_oracle_compute_unit_in_gbs",
+				Description: "The amount of memory (in GBs) enabled per each OCPU core in autonomous VM cluster.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("MemoryPerOracleComputeUnitInGBs"),
+			},
+			{
+				Name:        "next_maintenance_run_id",
+				Description: "The OCID of the next maintenance run.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "patch_id",
+				Description: "The OCID of the last patch applied on the system.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "patch_model",
+				Description: "Database patch model preference.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "reclaimable_cpus",
+				Description: "CPUs that continue to be included in the count of OCPUs available to the autonomous container database even after one of its autonomous database is terminated or scaled down.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "role",
+				Description: "The role of the autonomous data guard-enabled autonomous container database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_level_agreement_type",
+				Description: "The service level agreement type of the container database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "standby_maintenance_buffer_in_days",
+				Description: "The scheduling detail for the quarterly maintenance window of the standby autonomous container database.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "total_cpus",
+				Description: "The number of CPU cores allocated to the autonomous VM cluster.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "vault_id",
+				Description: "The OCID of the Oracle Cloud Infrastructure vault.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// json fields
+			{
+				Name:        "backup_config",
+				Description: "Backup options for the autonomous container database.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "key_history_entry",
+				Description: "Key history entry.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "maintenance_window",
+				Description: "The scheduling details for the quarterly maintenance window.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "provisionable_cpus",
+				Description: "An array of CPU values that can be used to successfully provision a single autonomous database.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// tags
+			{
+				Name:        "defined_tags",
+				Description: ColumnDescriptionDefinedTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "freeform_tags",
+				Description: ColumnDescriptionFreefromTags,
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(autonomousContainerDatabaseTags),
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id").Transform(ociRegionName),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listAutonomousContainerDatabases(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Debug("oci_database_autonomous_container_database.listAutonomousContainerDatabases", "Compartment", compartment, "OCI_REGION", region)
+
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given compartment_id doesn't match
+	if equalQuals["compartment_id"] != nil && compartment != equalQuals["compartment_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := databaseService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_database_autonomous_container_database.listAutonomousContainerDatabases", "connection_error", err)
+		return nil, err
+	}
+
+	request := database.ListAutonomousContainerDatabasesRequest{
+		CompartmentId: types.String(compartment),
+		Limit:         types.Int(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	// Check for additional filters
+	if equalQuals["autonomous_exadata_infrastructure_id"] != nil {
+		request.AutonomousExadataInfrastructureId = types.String(equalQuals["autonomous_exadata_infrastructure_id"].GetStringValue())
+	}
+	if equalQuals["autonomous_vm_cluster_id"] != nil {
+		request.AutonomousVmClusterId = types.String(equalQuals["autonomous_vm_cluster_id"].GetStringValue())
+	}
+	if equalQuals["cloud_autonomous_vm_cluster_id"] != nil {
+		request.CloudAutonomousVmClusterId = types.String(equalQuals["cloud_autonomous_vm_cluster_id"].GetStringValue())
+	}
+	if equalQuals["display_name"] != nil {
+		request.DisplayName = types.String(equalQuals["display_name"].GetStringValue())
+	}
+	if equalQuals["infrastructure_type"] != nil {
+		request.InfrastructureType = database.AutonomousContainerDatabaseSummaryInfrastructureTypeEnum(equalQuals["infrastructure_type"].GetStringValue())
+	}
+	if equalQuals["lifecycle_state"] != nil {
+		request.LifecycleState = database.AutonomousContainerDatabaseSummaryLifecycleStateEnum(equalQuals["lifecycle_state"].GetStringValue())
+	}
+
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < int64(*request.Limit) {
+			request.Limit = types.Int(int(*limit))
+		}
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.DatabaseClient.ListAutonomousContainerDatabases(ctx, request)
+		if err != nil {
+			logger.Error("oci_database_autonomous_container_database.listAutonomousContainerDatabases", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range response.Items {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTION
+
+func getAutonomousContainerDatabase(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Debug("oci_database_autonomous_container_database.getAutonomousContainerDatabase", "Compartment", compartment, "OCI_REGION", region)
+
+	// Restrict the api call to only root compartment/ per region
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		return nil, nil
+	}
+
+	id := d.KeyColumnQuals["id"].GetStringValue()
+
+	// handle empty id in get call
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := databaseService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_database_autonomous_container_database.getAutonomousContainerDatabase", "connection_error", err)
+		return nil, err
+	}
+
+	request := database.GetAutonomousContainerDatabaseRequest{
+		AutonomousContainerDatabaseId: types.String(id),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.DatabaseClient.GetAutonomousContainerDatabase(ctx, request)
+	if err != nil {
+		logger.Error("oci_database_autonomous_container_database.getAutonomousContainerDatabase", "api_error", err)
+		return nil, err
+	}
+
+	return response.AutonomousContainerDatabase, nil
+}
+
+//// TRANSFORM FUNCTION
+
+func autonomousContainerDatabaseTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	var freeformTags map[string]string
+	var definedTags map[string]map[string]interface{}
+
+	switch item := d.HydrateItem.(type) {
+	case database.AutonomousContainerDatabaseSummary:
+		freeformTags = item.FreeformTags
+		definedTags = item.DefinedTags
+	case database.AutonomousContainerDatabase:
+		freeformTags = item.FreeformTags
+		definedTags = item.DefinedTags
+	}
+
+	var tags map[string]interface{}
+
+	if freeformTags != nil {
+		tags = map[string]interface{}{}
+		for k, v := range freeformTags {
+			tags[k] = v
+		}
+	}
+
+	if definedTags != nil {
+		if tags == nil {
+			tags = map[string]interface{}{}
+		}
+		for _, v := range definedTags {
+			for key, value := range v {
+				tags[key] = value
+			}
+		}
+	}
+
+	return tags, nil
+}
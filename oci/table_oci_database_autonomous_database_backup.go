@@ -0,0 +1,279 @@
+package oci
+
+import (
+	"context"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/database"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableOciDatabaseAutonomousDatabaseBackup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_database_autonomous_database_backup",
+		Description:      "OCI Database Autonomous Database Backup",
+		DefaultTransform: transform.FromCamel(),
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getAutonomousDatabaseBackup,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAutonomousDatabaseBackups,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "autonomous_database_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "display_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "lifecycle_state",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "display_name",
+				Description: "The user-friendly name for the backup.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The OCID of the autonomous database backup.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "autonomous_database_id",
+				Description: "The OCID of the autonomous database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_state",
+				Description: "The current state of the backup.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of backup.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_automatic",
+				Description: "Indicates whether the backup is user-initiated or automatic.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "is_restorable",
+				Description: "Indicates whether the backup can be used to restore the associated autonomous database.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "time_started",
+				Description: "The date and time the backup started.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeStarted.Time"),
+			},
+			{
+				Name:        "time_ended",
+				Description: "The date and time the backup was completed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeEnded.Time"),
+			},
+			{
+				Name:        "database_size_in_tbs",
+				Description: "The size of the database in terabytes at the time the backup was taken.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("DatabaseSizeInTBs"),
+			},
+			{
+				Name:        "key_store_id",
+				Description: "The OCID of the key store.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "key_store_wallet_name",
+				Description: "The wallet name for Oracle Key Vault.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kms_key_id",
+				Description: "The OCID of the key container that is used as the master encryption key in database transparent data encryption (TDE) operations.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kms_key_version_id",
+				Description: "The OCID of the key container version that is used in database transparent data encryption (TDE) operations KMS Key can have multiple key versions.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_details",
+				Description: "Additional information about the current lifecycle state.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vault_id",
+				Description: "The OCID of the Oracle Cloud Infrastructure vault.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id").Transform(ociRegionName),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listAutonomousDatabaseBackups(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Debug("oci_database_autonomous_database_backup.listAutonomousDatabaseBackups", "Compartment", compartment, "OCI_REGION", region)
+
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given compartment_id doesn't match
+	if equalQuals["compartment_id"] != nil && compartment != equalQuals["compartment_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := databaseService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_database_autonomous_database_backup.listAutonomousDatabaseBackups", "connection_error", err)
+		return nil, err
+	}
+
+	request := database.ListAutonomousDatabaseBackupsRequest{
+		CompartmentId: types.String(compartment),
+		Limit:         types.Int(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	// Check for additional filters
+	if equalQuals["autonomous_database_id"] != nil {
+		request.AutonomousDatabaseId = types.String(equalQuals["autonomous_database_id"].GetStringValue())
+	}
+	if equalQuals["display_name"] != nil {
+		request.DisplayName = types.String(equalQuals["display_name"].GetStringValue())
+	}
+	if equalQuals["lifecycle_state"] != nil {
+		request.LifecycleState = database.AutonomousDatabaseBackupSummaryLifecycleStateEnum(equalQuals["lifecycle_state"].GetStringValue())
+	}
+
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < int64(*request.Limit) {
+			request.Limit = types.Int(int(*limit))
+		}
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.DatabaseClient.ListAutonomousDatabaseBackups(ctx, request)
+		if err != nil {
+			logger.Error("oci_database_autonomous_database_backup.listAutonomousDatabaseBackups", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range response.Items {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTION
+
+func getAutonomousDatabaseBackup(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Debug("oci_database_autonomous_database_backup.getAutonomousDatabaseBackup", "Compartment", compartment, "OCI_REGION", region)
+
+	// Restrict the api call to only root compartment/ per region
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		return nil, nil
+	}
+
+	id := d.KeyColumnQuals["id"].GetStringValue()
+
+	// handle empty id in get call
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := databaseService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_database_autonomous_database_backup.getAutonomousDatabaseBackup", "connection_error", err)
+		return nil, err
+	}
+
+	request := database.GetAutonomousDatabaseBackupRequest{
+		AutonomousDatabaseBackupId: types.String(id),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.DatabaseClient.GetAutonomousDatabaseBackup(ctx, request)
+	if err != nil {
+		logger.Error("oci_database_autonomous_database_backup.getAutonomousDatabaseBackup", "api_error", err)
+		return nil, err
+	}
+
+	return response.AutonomousDatabaseBackup, nil
+}
@@ -0,0 +1,340 @@
+package oci
+
+import (
+	"context"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/database"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableOciDatabaseAutonomousExadataInfrastructure(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_database_autonomous_exadata_infrastructure",
+		Description:      "OCI Database Autonomous Exadata Infrastructure",
+		DefaultTransform: transform.FromCamel(),
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getAutonomousExadataInfrastructure,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAutonomousExadataInfrastructures,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "availability_domain",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "display_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "lifecycle_state",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "display_name",
+				Description: "The user-friendly name for the autonomous exadata infrastructure.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The OCID of the autonomous exadata infrastructure.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_state",
+				Description: "The current lifecycle state of the autonomous exadata infrastructure.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_created",
+				Description: "The date and time the autonomous exadata infrastructure was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeCreated.Time"),
+			},
+			{
+				Name:        "availability_domain",
+				Description: "The name of the availability domain that the autonomous exadata infrastructure is located in.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "domain",
+				Description: "The domain name for the autonomous exadata infrastructure.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "hostname",
+				Description: "The host name for the autonomous exadata infrastructure node.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_maintenance_run_id",
+				Description: "The OCID of the last maintenance run.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "license_model",
+				Description: "The Oracle license model that applies to all databases in the autonomous exadata infrastructure.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_details",
+				Description: "Additional information about the current lifecycle state of the autonomous exadata infrastructure.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "next_maintenance_run_id",
+				Description: "The OCID of the next maintenance run.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "scan_dns_name",
+				Description: "The FQDN of the DNS record for the SCAN IP addresses that are associated with the autonomous exadata infrastructure.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "shape",
+				Description: "The shape of the autonomous exadata infrastructure.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "subnet_id",
+				Description: "The OCID of the subnet the autonomous exadata infrastructure is associated with.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "zone_id",
+				Description: "The OCID of the zone the autonomous exadata infrastructure is associated with.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// json fields
+			{
+				Name:        "maintenance_window",
+				Description: "The scheduling details for the quarterly maintenance window.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "nsg_ids",
+				Description: "A list of the OCIDs of the network security groups (NSGs) that this resource belongs to.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// tags
+			{
+				Name:        "defined_tags",
+				Description: ColumnDescriptionDefinedTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "freeform_tags",
+				Description: ColumnDescriptionFreefromTags,
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(autonomousExadataInfrastructureTags),
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id").Transform(ociRegionName),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listAutonomousExadataInfrastructures(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Debug("oci_database_autonomous_exadata_infrastructure.listAutonomousExadataInfrastructures", "Compartment", compartment, "OCI_REGION", region)
+
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given compartment_id doesn't match
+	if equalQuals["compartment_id"] != nil && compartment != equalQuals["compartment_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := databaseService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_database_autonomous_exadata_infrastructure.listAutonomousExadataInfrastructures", "connection_error", err)
+		return nil, err
+	}
+
+	request := database.ListAutonomousExadataInfrastructuresRequest{
+		CompartmentId: types.String(compartment),
+		Limit:         types.Int(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	// Check for additional filters
+	if equalQuals["availability_domain"] != nil {
+		request.AvailabilityDomain = types.String(equalQuals["availability_domain"].GetStringValue())
+	}
+	if equalQuals["display_name"] != nil {
+		request.DisplayName = types.String(equalQuals["display_name"].GetStringValue())
+	}
+	if equalQuals["lifecycle_state"] != nil {
+		request.LifecycleState = database.AutonomousExadataInfrastructureSummaryLifecycleStateEnum(equalQuals["lifecycle_state"].GetStringValue())
+	}
+
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < int64(*request.Limit) {
+			request.Limit = types.Int(int(*limit))
+		}
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.DatabaseClient.ListAutonomousExadataInfrastructures(ctx, request)
+		if err != nil {
+			logger.Error("oci_database_autonomous_exadata_infrastructure.listAutonomousExadataInfrastructures", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range response.Items {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTION
+
+func getAutonomousExadataInfrastructure(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Debug("oci_database_autonomous_exadata_infrastructure.getAutonomousExadataInfrastructure", "Compartment", compartment, "OCI_REGION", region)
+
+	// Restrict the api call to only root compartment/ per region
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		return nil, nil
+	}
+
+	id := d.KeyColumnQuals["id"].GetStringValue()
+
+	// handle empty id in get call
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := databaseService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_database_autonomous_exadata_infrastructure.getAutonomousExadataInfrastructure", "connection_error", err)
+		return nil, err
+	}
+
+	request := database.GetAutonomousExadataInfrastructureRequest{
+		AutonomousExadataInfrastructureId: types.String(id),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.DatabaseClient.GetAutonomousExadataInfrastructure(ctx, request)
+	if err != nil {
+		logger.Error("oci_database_autonomous_exadata_infrastructure.getAutonomousExadataInfrastructure", "api_error", err)
+		return nil, err
+	}
+
+	return response.AutonomousExadataInfrastructure, nil
+}
+
+//// TRANSFORM FUNCTION
+
+func autonomousExadataInfrastructureTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	var freeformTags map[string]string
+	var definedTags map[string]map[string]interface{}
+
+	switch item := d.HydrateItem.(type) {
+	case database.AutonomousExadataInfrastructureSummary:
+		freeformTags = item.FreeformTags
+		definedTags = item.DefinedTags
+	case database.AutonomousExadataInfrastructure:
+		freeformTags = item.FreeformTags
+		definedTags = item.DefinedTags
+	}
+
+	var tags map[string]interface{}
+
+	if freeformTags != nil {
+		tags = map[string]interface{}{}
+		for k, v := range freeformTags {
+			tags[k] = v
+		}
+	}
+
+	if definedTags != nil {
+		if tags == nil {
+			tags = map[string]interface{}{}
+		}
+		for _, v := range definedTags {
+			for key, value := range v {
+				tags[key] = value
+			}
+		}
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,381 @@
+package oci
+
+import (
+	"context"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/database"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableOciDatabaseCloudExadataInfrastructure(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_database_cloud_exadata_infrastructure",
+		Description:      "OCI Database Cloud Exadata Infrastructure",
+		DefaultTransform: transform.FromCamel(),
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getCloudExadataInfrastructure,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCloudExadataInfrastructures,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "display_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "lifecycle_state",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "display_name",
+				Description: "The user-friendly name for the cloud exadata infrastructure resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The OCID of the cloud exadata infrastructure resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_state",
+				Description: "The current lifecycle state of the cloud exadata infrastructure resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_created",
+				Description: "The date and time the cloud exadata infrastructure resource was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeCreated.Time"),
+			},
+			{
+				Name:        "activated_storage_count",
+				Description: "The requested number of additional storage servers activated for the exadata infrastructure.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "additional_storage_count",
+				Description: "The requested number of additional storage servers for the exadata infrastructure.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "availability_domain",
+				Description: "The name of the availability domain that the cloud exadata infrastructure resource is located in.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "available_storage_size_in_gbs",
+				Description: "The available storage can be allocated to the cloud exadata infrastructure resource, in gigabytes (GB).",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("AvailableStorageSizeInGBs"),
+			},
+			{
+				Name:        "compute_count",
+				Description: "The number of compute servers for the cloud exadata infrastructure.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "cpu_count",
+				Description: "The total number of CPU cores allocated.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "data_storage_size_in_tbs",
+				Description: "Size, in terabytes, of the DATA disk group.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("DataStorageSizeInTBs"),
+			},
+			{
+				Name:        "db_node_storage_size_in_gbs",
+				Description: "The local node storage allocated in GBs.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("DbNodeStorageSizeInGBs"),
+			},
+			{
+				Name:        "last_maintenance_run_id",
+				Description: "The OCID of the last maintenance run.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_details",
+				Description: "Additional information about the current lifecycle state.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "max_cpu_count",
+				Description: "The total number of CPU cores available.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "max_data_storage_in_tbs",
+				Description: "The total available DATA disk group size.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("MaxDataStorageInTBs"),
+			},
+			{
+				Name:        "max_db_node_storage_in_gbs",
+				Description: "The total local node storage available in GBs.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("MaxDbNodeStorageInGBs"),
+			},
+			{
+				Name:        "max_memory_in_gbs",
+				Description: "The total memory available in GBs.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("MaxMemoryInGBs"),
+			},
+			{
+				Name:        "memory_size_in_gbs",
+				Description: "The memory allocated in GBs.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("MemorySizeInGBs"),
+			},
+			{
+				Name:        "next_maintenance_run_id",
+				Description: "The OCID of the next maintenance run.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "shape",
+				Description: "The model name of the cloud exadata infrastructure resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "storage_count",
+				Description: "The number of storage servers for the cloud exadata infrastructure.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "total_storage_size_in_gbs",
+				Description: "The total storage allocated to the cloud exadata infrastructure resource, in gigabytes (GB).",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("TotalStorageSizeInGBs"),
+			},
+
+			// json fields
+			{
+				Name:        "customer_contacts",
+				Description: "The list of customer email addresses that receive information from Oracle about the specified OCI database service resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "maintenance_window",
+				Description: "The scheduling details for the quarterly maintenance window.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// tags
+			{
+				Name:        "defined_tags",
+				Description: ColumnDescriptionDefinedTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "freeform_tags",
+				Description: ColumnDescriptionFreefromTags,
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(cloudExadataInfrastructureTags),
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id").Transform(ociRegionName),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudExadataInfrastructures(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Debug("oci_database_cloud_exadata_infrastructure.listCloudExadataInfrastructures", "Compartment", compartment, "OCI_REGION", region)
+
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given compartment_id doesn't match
+	if equalQuals["compartment_id"] != nil && compartment != equalQuals["compartment_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := databaseService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_database_cloud_exadata_infrastructure.listCloudExadataInfrastructures", "connection_error", err)
+		return nil, err
+	}
+
+	request := database.ListCloudExadataInfrastructuresRequest{
+		CompartmentId: types.String(compartment),
+		Limit:         types.Int(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	// Check for additional filters
+	if equalQuals["display_name"] != nil {
+		request.DisplayName = types.String(equalQuals["display_name"].GetStringValue())
+	}
+	if equalQuals["lifecycle_state"] != nil {
+		request.LifecycleState = database.CloudExadataInfrastructureSummaryLifecycleStateEnum(equalQuals["lifecycle_state"].GetStringValue())
+	}
+
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < int64(*request.Limit) {
+			request.Limit = types.Int(int(*limit))
+		}
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.DatabaseClient.ListCloudExadataInfrastructures(ctx, request)
+		if err != nil {
+			logger.Error("oci_database_cloud_exadata_infrastructure.listCloudExadataInfrastructures", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range response.Items {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTION
+
+func getCloudExadataInfrastructure(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Debug("oci_database_cloud_exadata_infrastructure.getCloudExadataInfrastructure", "Compartment", compartment, "OCI_REGION", region)
+
+	// Restrict the api call to only root compartment/ per region
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		return nil, nil
+	}
+
+	id := d.KeyColumnQuals["id"].GetStringValue()
+
+	// handle empty id in get call
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := databaseService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_database_cloud_exadata_infrastructure.getCloudExadataInfrastructure", "connection_error", err)
+		return nil, err
+	}
+
+	request := database.GetCloudExadataInfrastructureRequest{
+		CloudExadataInfrastructureId: types.String(id),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.DatabaseClient.GetCloudExadataInfrastructure(ctx, request)
+	if err != nil {
+		logger.Error("oci_database_cloud_exadata_infrastructure.getCloudExadataInfrastructure", "api_error", err)
+		return nil, err
+	}
+
+	return response.CloudExadataInfrastructure, nil
+}
+
+//// TRANSFORM FUNCTION
+
+func cloudExadataInfrastructureTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	var freeformTags map[string]string
+	var definedTags map[string]map[string]interface{}
+
+	switch item := d.HydrateItem.(type) {
+	case database.CloudExadataInfrastructureSummary:
+		freeformTags = item.FreeformTags
+		definedTags = item.DefinedTags
+	case database.CloudExadataInfrastructure:
+		freeformTags = item.FreeformTags
+		definedTags = item.DefinedTags
+	}
+
+	var tags map[string]interface{}
+
+	if freeformTags != nil {
+		tags = map[string]interface{}{}
+		for k, v := range freeformTags {
+			tags[k] = v
+		}
+	}
+
+	if definedTags != nil {
+		if tags == nil {
+			tags = map[string]interface{}{}
+		}
+		for _, v := range definedTags {
+			for key, value := range v {
+				tags[key] = value
+			}
+		}
+	}
+
+	return tags, nil
+}
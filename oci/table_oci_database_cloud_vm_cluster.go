@@ -0,0 +1,469 @@
+package oci
+
+import (
+	"context"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/database"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableOciDatabaseCloudVmCluster(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_database_cloud_vm_cluster",
+		Description:      "OCI Database Cloud VM Cluster",
+		DefaultTransform: transform.FromCamel(),
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getCloudVmCluster,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCloudVmClusters,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "cloud_exadata_infrastructure_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "display_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "lifecycle_state",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "display_name",
+				Description: "The user-friendly name for the cloud VM cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The OCID of the cloud VM cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cloud_exadata_infrastructure_id",
+				Description: "The OCID of the cloud exadata infrastructure.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_state",
+				Description: "The current state of the cloud VM cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_created",
+				Description: "The date and time that the cloud VM cluster was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeCreated.Time"),
+			},
+			{
+				Name:        "availability_domain",
+				Description: "The name of the availability domain that the cloud exadata infrastructure resource is located in.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "backup_subnet_id",
+				Description: "The OCID of the backup network subnet associated with the cloud VM cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cluster_name",
+				Description: "The cluster name for cloud VM cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cpu_core_count",
+				Description: "The number of CPU cores enabled on the cloud VM cluster.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "data_storage_percentage",
+				Description: "The percentage assigned to DATA storage (user data and database files).",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "data_storage_size_in_tbs",
+				Description: "The data disk group size to be allocated in TBs.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("DataStorageSizeInTBs"),
+			},
+			{
+				Name:        "db_node_storage_size_in_gbs",
+				Description: "The local node storage to be allocated in GBs.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("DbNodeStorageSizeInGBs"),
+			},
+			{
+				Name:        "disk_redundancy",
+				Description: "The type of redundancy configured for the cloud VM cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "domain",
+				Description: "The domain name for the cloud VM cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "gi_version",
+				Description: "A valid Oracle Grid Infrastructure (GI) software version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "hostname",
+				Description: "The hostname for the cloud VM cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_local_backup_enabled",
+				Description: "If true, database backup on local Exadata storage is configured for the cloud VM cluster.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "is_sparse_diskgroup_enabled",
+				Description: "If true, sparse disk group is configured for the cloud VM cluster.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "last_update_history_entry_id",
+				Description: "The OCID of the last maintenance update history entry.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "license_model",
+				Description: "The Oracle license model that applies to the cloud VM cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_details",
+				Description: "Additional information about the current lifecycle state.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "listener_port",
+				Description: "The port number configured for the listener on the cloud VM cluster.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "memory_size_in_gbs",
+				Description: "The memory to be allocated in GBs.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("MemorySizeInGBs"),
+			},
+			{
+				Name:        "node_count",
+				Description: "The number of nodes in the cloud VM cluster.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "ocpu_count",
+				Description: "The number of OCPU cores to enable on the cloud VM cluster.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "scan_dns_name",
+				Description: "The FQDN of the DNS record for the SCAN IP addresses that are associated with the cloud VM cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "scan_dns_record_id",
+				Description: "The OCID of the DNS record for the SCAN IP addresses that are associated with the cloud VM cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "scan_listener_port_tcp",
+				Description: "The TCP Single Client Access Name (SCAN) port.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "scan_listener_port_tcp_ssl",
+				Description: "The TCPS Single Client Access Name (SCAN) port.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "shape",
+				Description: "The model name of the exadata hardware running the cloud VM cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "storage_size_in_gbs",
+				Description: "The storage allocation for the disk group, in gigabytes (GB).",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("StorageSizeInGBs"),
+			},
+			{
+				Name:        "subnet_id",
+				Description: "The OCID of the subnet associated with the cloud VM cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "system_version",
+				Description: "Operating system version of the image.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_zone",
+				Description: "The time zone of the cloud VM cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "zone_id",
+				Description: "The OCID of the zone the cloud VM cluster is associated with.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// json fields
+			{
+				Name:        "backup_network_nsg_ids",
+				Description: "A list of the OCIDs of the network security groups (NSGs) that the backup network of this DB system belongs to.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "data_collection_options",
+				Description: "Indicates user preferences for the various diagnostic collection options for the VM cluster.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "db_servers",
+				Description: "The list of DB servers.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "nsg_ids",
+				Description: "A list of the OCIDs of the network security groups (NSGs) that this resource belongs to.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "scan_ip_ids",
+				Description: "The OCID of the single client access name (SCAN) IP addresses associated with the cloud VM cluster.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "ssh_public_keys",
+				Description: "The public key portion of one or more key pairs used for SSH access to the cloud VM cluster.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "vip_ids",
+				Description: "The OCID of the virtual IP (VIP) addresses associated with the cloud VM cluster.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// tags
+			{
+				Name:        "defined_tags",
+				Description: ColumnDescriptionDefinedTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "freeform_tags",
+				Description: ColumnDescriptionFreefromTags,
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(cloudVmClusterTags),
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id").Transform(ociRegionName),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudVmClusters(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Debug("oci_database_cloud_vm_cluster.listCloudVmClusters", "Compartment", compartment, "OCI_REGION", region)
+
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given compartment_id doesn't match
+	if equalQuals["compartment_id"] != nil && compartment != equalQuals["compartment_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := databaseService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_database_cloud_vm_cluster.listCloudVmClusters", "connection_error", err)
+		return nil, err
+	}
+
+	request := database.ListCloudVmClustersRequest{
+		CompartmentId: types.String(compartment),
+		Limit:         types.Int(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	// Check for additional filters
+	if equalQuals["cloud_exadata_infrastructure_id"] != nil {
+		request.CloudExadataInfrastructureId = types.String(equalQuals["cloud_exadata_infrastructure_id"].GetStringValue())
+	}
+	if equalQuals["display_name"] != nil {
+		request.DisplayName = types.String(equalQuals["display_name"].GetStringValue())
+	}
+	if equalQuals["lifecycle_state"] != nil {
+		request.LifecycleState = database.CloudVmClusterSummaryLifecycleStateEnum(equalQuals["lifecycle_state"].GetStringValue())
+	}
+
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < int64(*request.Limit) {
+			request.Limit = types.Int(int(*limit))
+		}
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.DatabaseClient.ListCloudVmClusters(ctx, request)
+		if err != nil {
+			logger.Error("oci_database_cloud_vm_cluster.listCloudVmClusters", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range response.Items {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTION
+
+func getCloudVmCluster(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Debug("oci_database_cloud_vm_cluster.getCloudVmCluster", "Compartment", compartment, "OCI_REGION", region)
+
+	// Restrict the api call to only root compartment/ per region
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		return nil, nil
+	}
+
+	id := d.KeyColumnQuals["id"].GetStringValue()
+
+	// handle empty id in get call
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := databaseService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_database_cloud_vm_cluster.getCloudVmCluster", "connection_error", err)
+		return nil, err
+	}
+
+	request := database.GetCloudVmClusterRequest{
+		CloudVmClusterId: types.String(id),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.DatabaseClient.GetCloudVmCluster(ctx, request)
+	if err != nil {
+		logger.Error("oci_database_cloud_vm_cluster.getCloudVmCluster", "api_error", err)
+		return nil, err
+	}
+
+	return response.CloudVmCluster, nil
+}
+
+//// TRANSFORM FUNCTION
+
+func cloudVmClusterTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	var freeformTags map[string]string
+	var definedTags map[string]map[string]interface{}
+
+	switch item := d.HydrateItem.(type) {
+	case database.CloudVmClusterSummary:
+		freeformTags = item.FreeformTags
+		definedTags = item.DefinedTags
+	case database.CloudVmCluster:
+		freeformTags = item.FreeformTags
+		definedTags = item.DefinedTags
+	}
+
+	var tags map[string]interface{}
+
+	if freeformTags != nil {
+		tags = map[string]interface{}{}
+		for k, v := range freeformTags {
+			tags[k] = v
+		}
+	}
+
+	if definedTags != nil {
+		if tags == nil {
+			tags = map[string]interface{}{}
+		}
+		for _, v := range definedTags {
+			for key, value := range v {
+				tags[key] = value
+			}
+		}
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,247 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/database"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableOciDatabaseDataGuardAssociation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_database_data_guard_association",
+		Description:      "OCI Database Data Guard Association",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			ParentHydrate:     listDatabaseDBHomes,
+			Hydrate:           listDatabaseDataGuardAssociations,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "database_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The OCID of the Data Guard association.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "database_id",
+				Description: "The OCID of the reporting database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "db_home_id",
+				Description: "The OCID of the database home of the reporting database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "role",
+				Description: "The role of the reporting database in this Data Guard association.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_state",
+				Description: "The current state of the Data Guard association.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_created",
+				Description: "The date and time the Data Guard association was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeCreated.Time"),
+			},
+			{
+				Name:        "apply_lag",
+				Description: "The lag time between updates to the primary database and application of the redo data on the standby database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "apply_rate",
+				Description: "The rate at which redo logs are synced between the associated databases.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_active_data_guard_enabled",
+				Description: "True if active Data Guard is enabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "lifecycle_details",
+				Description: "Additional information about the current lifecycle state.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "peer_data_guard_association_id",
+				Description: "The OCID of the peer database's Data Guard association.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "peer_database_id",
+				Description: "The OCID of the associated peer database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "peer_db_home_id",
+				Description: "The OCID of the database home containing the associated peer database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "peer_db_system_id",
+				Description: "The OCID of the DB system containing the associated peer database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "peer_role",
+				Description: "The role of the peer database in this Data Guard association.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "protection_mode",
+				Description: "The protection mode of this Data Guard association.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "transport_type",
+				Description: "The redo transport type used by this Data Guard association.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id").Transform(ociRegionName),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+type dataGuardAssociationInfo struct {
+	database.DataGuardAssociationSummary
+	DbHomeId      *string
+	CompartmentId *string
+}
+
+//// LIST FUNCTION
+
+func listDatabaseDataGuardAssociations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Debug("oci_database_data_guard_association.listDatabaseDataGuardAssociations", "Compartment", compartment, "OCI_REGION", region)
+
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given compartment_id doesn't match
+	if equalQuals["compartment_id"] != nil && compartment != equalQuals["compartment_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	homeId := h.Item.(database.DbHomeSummary).Id
+
+	// Create Session
+	session, err := databaseService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_database_data_guard_association.listDatabaseDataGuardAssociations", "connection_error", err)
+		return nil, err
+	}
+
+	// Data Guard associations are listed per database, so collect the
+	// databases of the home first
+	var databases []database.DatabaseSummary
+	databaseRequest := database.ListDatabasesRequest{
+		CompartmentId: types.String(compartment),
+		DbHomeId:      homeId,
+		Limit:         types.Int(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+	for pagesLeft := true; pagesLeft; {
+		response, err := session.DatabaseClient.ListDatabases(ctx, databaseRequest)
+		if err != nil {
+			logger.Error("oci_database_data_guard_association.listDatabaseDataGuardAssociations", "api_error", err)
+			return nil, err
+		}
+		databases = append(databases, response.Items...)
+		databaseRequest.Page = response.OpcNextPage
+		pagesLeft = response.OpcNextPage != nil
+	}
+
+	for _, db := range databases {
+		// Skip, if given database_id doesn't match
+		if equalQuals["database_id"] != nil && equalQuals["database_id"].GetStringValue() != types.SafeString(db.Id) {
+			continue
+		}
+
+		request := database.ListDataGuardAssociationsRequest{
+			DatabaseId: db.Id,
+			Limit:      types.Int(1000),
+			RequestMetadata: common.RequestMetadata{
+				RetryPolicy: getDefaultRetryPolicy(d.Connection),
+			},
+		}
+
+		pagesLeft := true
+		for pagesLeft {
+			response, err := session.DatabaseClient.ListDataGuardAssociations(ctx, request)
+			if err != nil {
+				logger.Error("oci_database_data_guard_association.listDatabaseDataGuardAssociations", "api_error", err)
+				return nil, err
+			}
+
+			for _, association := range response.Items {
+				d.StreamListItem(ctx, dataGuardAssociationInfo{association, homeId, db.CompartmentId})
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+			if response.OpcNextPage != nil {
+				request.Page = response.OpcNextPage
+			} else {
+				pagesLeft = false
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,335 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/database"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableOciDatabaseDbNode(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_database_db_node",
+		Description:      "OCI Database DB Node",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			Hydrate: listDatabaseDbNodes,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "db_system_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "vm_cluster_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "db_server_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "lifecycle_state",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "hostname",
+				Description: "The host name for the database node.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The OCID of the database node.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "db_system_id",
+				Description: "The OCID of the DB system the database node belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vm_cluster_id",
+				Description: "The OCID of the cloud VM cluster the database node belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_state",
+				Description: "The current state of the database node.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_created",
+				Description: "The date and time that the database node was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeCreated.Time"),
+			},
+			{
+				Name:        "additional_details",
+				Description: "Additional information about the planned maintenance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "backup_ip_id",
+				Description: "The OCID of the backup IP address associated with the database node.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "backup_vnic_id",
+				Description: "The OCID of the second VNIC.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cpu_core_count",
+				Description: "The number of CPU cores enabled on the database node.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "db_node_storage_size_in_gbs",
+				Description: "The allocated local node storage in GBs on the database node.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("DbNodeStorageSizeInGBs"),
+			},
+			{
+				Name:        "db_server_id",
+				Description: "The OCID of the Exacc DB server associated with the database node.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "fault_domain",
+				Description: "The name of the fault domain the instance is contained in.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "host_ip_id",
+				Description: "The OCID of the host IP address associated with the database node.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "maintenance_type",
+				Description: "The type of database node maintenance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "memory_size_in_gbs",
+				Description: "The allocated memory in GBs on the database node.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("MemorySizeInGBs"),
+			},
+			{
+				Name:        "software_storage_size_in_gb",
+				Description: "The size (in GB) of the block storage volume allocation for the DB system.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("SoftwareStorageSizeInGB"),
+			},
+			{
+				Name:        "time_maintenance_window_end",
+				Description: "End date and time of maintenance window.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeMaintenanceWindowEnd.Time"),
+			},
+			{
+				Name:        "time_maintenance_window_start",
+				Description: "Start date and time of maintenance window.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeMaintenanceWindowStart.Time"),
+			},
+			{
+				Name:        "vnic_id",
+				Description: "The OCID of the VNIC.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Hostname"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id").Transform(ociRegionName),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromMatrixItem(matrixKeyCompartment),
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+type databaseDbNodeInfo struct {
+	database.DbNodeSummary
+	VmClusterId *string
+}
+
+//// LIST FUNCTION
+
+func listDatabaseDbNodes(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Debug("oci_database_db_node.listDatabaseDbNodes", "Compartment", compartment, "OCI_REGION", region)
+
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given compartment_id doesn't match
+	if equalQuals["compartment_id"] != nil && compartment != equalQuals["compartment_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := databaseService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_database_db_node.listDatabaseDbNodes", "connection_error", err)
+		return nil, err
+	}
+
+	// ListDbNodes requires either a DB system or a VM cluster, so use the given
+	// ones directly or else collect every parent in the compartment
+	var dbSystemIds, vmClusterIds []string
+	if equalQuals["db_system_id"] != nil || equalQuals["vm_cluster_id"] != nil {
+		if equalQuals["db_system_id"] != nil {
+			dbSystemIds = append(dbSystemIds, equalQuals["db_system_id"].GetStringValue())
+		}
+		if equalQuals["vm_cluster_id"] != nil {
+			vmClusterIds = append(vmClusterIds, equalQuals["vm_cluster_id"].GetStringValue())
+		}
+	} else {
+		dbSystemRequest := database.ListDbSystemsRequest{
+			CompartmentId: types.String(compartment),
+			Limit:         types.Int(1000),
+			RequestMetadata: common.RequestMetadata{
+				RetryPolicy: getDefaultRetryPolicy(d.Connection),
+			},
+		}
+		for pagesLeft := true; pagesLeft; {
+			response, err := session.DatabaseClient.ListDbSystems(ctx, dbSystemRequest)
+			if err != nil {
+				logger.Error("oci_database_db_node.listDatabaseDbNodes", "api_error", err)
+				return nil, err
+			}
+			for _, dbSystem := range response.Items {
+				dbSystemIds = append(dbSystemIds, *dbSystem.Id)
+			}
+			dbSystemRequest.Page = response.OpcNextPage
+			pagesLeft = response.OpcNextPage != nil
+		}
+
+		vmClusterRequest := database.ListCloudVmClustersRequest{
+			CompartmentId: types.String(compartment),
+			Limit:         types.Int(1000),
+			RequestMetadata: common.RequestMetadata{
+				RetryPolicy: getDefaultRetryPolicy(d.Connection),
+			},
+		}
+		for pagesLeft := true; pagesLeft; {
+			response, err := session.DatabaseClient.ListCloudVmClusters(ctx, vmClusterRequest)
+			if err != nil {
+				logger.Error("oci_database_db_node.listDatabaseDbNodes", "api_error", err)
+				return nil, err
+			}
+			for _, vmCluster := range response.Items {
+				vmClusterIds = append(vmClusterIds, *vmCluster.Id)
+			}
+			vmClusterRequest.Page = response.OpcNextPage
+			pagesLeft = response.OpcNextPage != nil
+		}
+	}
+
+	request := database.ListDbNodesRequest{
+		CompartmentId: types.String(compartment),
+		Limit:         types.Int(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	// Check for additional filters
+	if equalQuals["db_server_id"] != nil {
+		request.DbServerId = types.String(equalQuals["db_server_id"].GetStringValue())
+	}
+	if equalQuals["lifecycle_state"] != nil {
+		request.LifecycleState = database.DbNodeSummaryLifecycleStateEnum(equalQuals["lifecycle_state"].GetStringValue())
+	}
+
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < int64(*request.Limit) {
+			request.Limit = types.Int(int(*limit))
+		}
+	}
+
+	for _, id := range dbSystemIds {
+		request.DbSystemId = types.String(id)
+		request.VmClusterId = nil
+		if done, err := streamDatabaseDbNodes(ctx, d, session.DatabaseClient, request); err != nil || done {
+			return nil, err
+		}
+	}
+	for _, id := range vmClusterIds {
+		request.DbSystemId = nil
+		request.VmClusterId = types.String(id)
+		if done, err := streamDatabaseDbNodes(ctx, d, session.DatabaseClient, request); err != nil || done {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+// streamDatabaseDbNodes pages through the nodes of a single DB system or VM
+// cluster, reporting whether the row limit has been reached
+func streamDatabaseDbNodes(ctx context.Context, d *plugin.QueryData, client database.DatabaseClient, request database.ListDbNodesRequest) (bool, error) {
+	request.Page = nil
+	pagesLeft := true
+	for pagesLeft {
+		response, err := client.ListDbNodes(ctx, request)
+		if err != nil {
+			plugin.Logger(ctx).Error("oci_database_db_node.streamDatabaseDbNodes", "api_error", err)
+			return false, err
+		}
+
+		for _, node := range response.Items {
+			d.StreamListItem(ctx, databaseDbNodeInfo{node, request.VmClusterId})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return true, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return false, nil
+}
@@ -43,6 +43,12 @@ func tableFunctionsApplication(_ context.Context) *plugin.Table {
 				},
 			},
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:    getFunctionsApplicationConfig,
+				Depends: []plugin.HydrateFunc{getFunctionsApplication},
+			},
+		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
 		Columns: []*plugin.Column{
 			{
@@ -84,7 +90,19 @@ func tableFunctionsApplication(_ context.Context) *plugin.Table {
 				Name:        "config",
 				Description: "Application configuration for functions in this application.",
 				Type:        proto.ColumnType_JSON,
-				Hydrate:     getFunctionsApplication,
+				Hydrate:     getFunctionsApplicationConfig,
+			},
+			{
+				Name:        "config_keys",
+				Description: "The key names of the application configuration.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getFunctionsApplicationConfig,
+			},
+			{
+				Name:        "config_secret_findings",
+				Description: "The application configuration keys whose values look like plaintext secrets, with the rule that matched. Values are never returned.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getFunctionsApplicationConfig,
 			},
 			{
 				Name:        "subnet_ids",
@@ -250,6 +268,17 @@ func getFunctionsApplication(ctx context.Context, d *plugin.QueryData, h *plugin
 	return response.Application, nil
 }
 
+func getFunctionsApplicationConfig(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// The configuration is only returned by the get call, whose result is
+	// passed in for both get and list queries
+	application, ok := h.HydrateResults["getFunctionsApplication"].(functions.Application)
+	if !ok {
+		return nil, nil
+	}
+
+	return buildConfigVisibilityInfo(application.Config), nil
+}
+
 //// TRANSFORM FUNCTION
 
 // Priority order for tags
@@ -36,6 +36,12 @@ func tableFunctionsFunction(_ context.Context) *plugin.Table {
 				},
 			},
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:    getFunctionConfig,
+				Depends: []plugin.HydrateFunc{getFunction},
+			},
+		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
 		Columns: []*plugin.Column{
 			{
@@ -104,7 +110,19 @@ func tableFunctionsFunction(_ context.Context) *plugin.Table {
 				Name:        "config",
 				Description: "The function configuration. Overrides application configuration.",
 				Type:        proto.ColumnType_JSON,
-				Hydrate:     getFunction,
+				Hydrate:     getFunctionConfig,
+			},
+			{
+				Name:        "config_keys",
+				Description: "The key names of the function configuration.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getFunctionConfig,
+			},
+			{
+				Name:        "config_secret_findings",
+				Description: "The function configuration keys whose values look like plaintext secrets, with the rule that matched. Values are never returned.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getFunctionConfig,
 			},
 			{
 				Name:        "trace_config",
@@ -283,6 +301,17 @@ func getFunction(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData
 	return response.Function, nil
 }
 
+func getFunctionConfig(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// The configuration is only returned by the get call, whose result is
+	// passed in for both get and list queries
+	function, ok := h.HydrateResults["getFunction"].(functions.Function)
+	if !ok {
+		return nil, nil
+	}
+
+	return buildConfigVisibilityInfo(function.Config), nil
+}
+
 //// TRANSFORM FUNCTION
 
 // Priority order for tags
@@ -0,0 +1,276 @@
+package oci
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableFunctionsPbfListing(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_functions_pbf_listing",
+		Description:      "OCI Functions Pre-Built Function Listing",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			Hydrate: listFunctionsPbfListings,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "lifecycle_state",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the pre-built function.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The OCID of the pre-built function listing.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "A short description of what the pre-built function does.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_state",
+				Description: "The current state of the listing.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "publisher_name",
+				Description: "The name of the publisher of the pre-built function.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PublisherDetails.Name"),
+			},
+			{
+				Name:        "time_created",
+				Description: "The date and time the listing was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeCreated.Time"),
+			},
+			{
+				Name:        "time_updated",
+				Description: "The date and time the listing was updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeUpdated.Time"),
+			},
+
+			// json fields
+			{
+				Name:        "triggers",
+				Description: "The services that can trigger the pre-built function.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// tags
+			{
+				Name:        "defined_tags",
+				Description: ColumnDescriptionDefinedTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "freeform_tags",
+				Description: ColumnDescriptionFreefromTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "system_tags",
+				Description: ColumnDescriptionSystemTags,
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(functionsPbfListingTags),
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+// functionsPbfListing is a pre-built function listing as returned by the
+// Functions API, which the SDK doesn't cover yet
+type functionsPbfListing struct {
+	Id               *string `json:"id"`
+	Name             *string `json:"name"`
+	Description      *string `json:"description"`
+	LifecycleState   *string `json:"lifecycleState"`
+	PublisherDetails *struct {
+		Name *string `json:"name"`
+	} `json:"publisherDetails"`
+	Triggers []struct {
+		Name *string `json:"name"`
+	} `json:"triggers"`
+	TimeCreated  *common.SDKTime                   `json:"timeCreated"`
+	TimeUpdated  *common.SDKTime                   `json:"timeUpdated"`
+	FreeformTags map[string]string                 `json:"freeformTags"`
+	DefinedTags  map[string]map[string]interface{} `json:"definedTags"`
+	SystemTags   map[string]map[string]interface{} `json:"systemTags"`
+	Region       string                            `json:"-"`
+}
+
+type functionsPbfListingCollection struct {
+	Items []functionsPbfListing `json:"items"`
+}
+
+//// LIST FUNCTION
+
+func listFunctionsPbfListings(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	logger.Trace("listFunctionsPbfListings", "OCI_REGION", region)
+
+	// Create Session
+	session, err := functionsManagementService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_functions_pbf_listing.listFunctionsPbfListings", "connection_error", err)
+		return nil, err
+	}
+
+	// Build request parameters
+	query := buildFunctionsPbfListingFilters(d.KeyColumnQuals)
+	query.Set("compartmentId", session.TenancyID)
+	limit := 100
+
+	// Check for limit
+	if d.QueryContext.Limit != nil {
+		if *d.QueryContext.Limit < int64(limit) {
+			limit = int(*d.QueryContext.Limit)
+		}
+	}
+	query.Set("limit", strconv.Itoa(limit))
+
+	pagesLeft := true
+	for pagesLeft {
+		var response functionsPbfListingCollection
+		header, err := callSignedRequestWithHeaders(ctx, d, session.FunctionsManagementClient.BaseClient, "/pbfListings", query, &response)
+		if err != nil {
+			// Pre-built functions are not offered in every region
+			if ociErr, ok := err.(common.ServiceError); ok && ociErr.GetHTTPStatusCode() == 404 {
+				return nil, nil
+			}
+			logger.Error("oci_functions_pbf_listing.listFunctionsPbfListings", "api_error", err)
+			return nil, err
+		}
+
+		for _, listing := range response.Items {
+			listing.Region = region
+			d.StreamListItem(ctx, listing)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if page := header.Get("opc-next-page"); page != "" {
+			query.Set("page", page)
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTION
+
+// Priority order for tags
+// 1. System Tags
+// 2. Defined Tags
+// 3. Free-form tags
+func functionsPbfListingTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	listing := d.HydrateItem.(functionsPbfListing)
+
+	var tags map[string]interface{}
+
+	if listing.FreeformTags != nil {
+		tags = map[string]interface{}{}
+		for k, v := range listing.FreeformTags {
+			tags[k] = v
+		}
+	}
+
+	if listing.DefinedTags != nil {
+		if tags == nil {
+			tags = map[string]interface{}{}
+		}
+		for _, v := range listing.DefinedTags {
+			for key, value := range v {
+				tags[key] = value
+			}
+		}
+	}
+
+	if listing.SystemTags != nil {
+		if tags == nil {
+			tags = map[string]interface{}{}
+		}
+		for _, v := range listing.SystemTags {
+			for key, value := range v {
+				tags[key] = value
+			}
+		}
+	}
+
+	return tags, nil
+}
+
+// Build additional filters
+func buildFunctionsPbfListingFilters(equalQuals plugin.KeyColumnEqualsQualMap) url.Values {
+	query := url.Values{}
+
+	if equalQuals["id"] != nil {
+		query.Set("pbfListingId", equalQuals["id"].GetStringValue())
+	}
+	if equalQuals["name"] != nil {
+		query.Set("name", equalQuals["name"].GetStringValue())
+	}
+	if equalQuals["lifecycle_state"] != nil {
+		query.Set("lifecycleState", equalQuals["lifecycle_state"].GetStringValue())
+	}
+
+	return query
+}
@@ -76,6 +76,43 @@ func tableIdentityCompartment(_ context.Context) *plugin.Table {
 				Description: "Indicates whether or not the compartment is accessible for the user making the request.",
 				Type:        proto.ColumnType_BOOL,
 			},
+			{
+				Name:        "path",
+				Description: "The names of the compartment and its ancestors joined with \"/\", starting from the root compartment, e.g. root/Prod/Network.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCompartmentHierarchy,
+				Transform:   transform.FromField("Path"),
+			},
+			{
+				Name:        "depth",
+				Description: "The number of ancestors of the compartment. The root compartment has a depth of 0.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getCompartmentHierarchy,
+				Transform:   transform.FromField("Depth"),
+			},
+			{
+				Name:        "ancestor_ids",
+				Description: "The OCIDs of the ancestors of the compartment, starting from the root compartment.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCompartmentHierarchy,
+				Transform:   transform.FromField("AncestorIds"),
+			},
+			{
+				Name:        "root_compartment_id",
+				Description: "The OCID of the root compartment of the tenancy.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCompartmentHierarchy,
+				Transform:   transform.FromField("RootCompartmentId"),
+			},
+			{
+				Name:        "security_zone_id",
+				Description: "The OCID of the security zone that protects the compartment, either its own or one inherited from its nearest ancestor.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCompartmentSecurityZoneId,
+				Transform:   transform.FromValue(),
+			},
+
+			// tags
 			{
 				Name:        "defined_tags",
 				Description: ColumnDescriptionDefinedTags,
@@ -227,6 +264,69 @@ func getCompartment(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateD
 	return response.Compartment, nil
 }
 
+type compartmentHierarchy struct {
+	Path              string
+	Depth             int
+	AncestorIds       []string
+	RootCompartmentId string
+}
+
+func getCompartmentHierarchy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := types.SafeString(h.Item.(identity.Compartment).Id)
+
+	tree, err := getCompartmentTree(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("getCompartmentHierarchy", "api_error", err)
+		return nil, err
+	}
+
+	// Compartments being created or deleted are not part of the tree
+	path, ok := tree.path(id)
+	if !ok {
+		return nil, nil
+	}
+
+	ancestors := tree.ancestors(id)
+	return compartmentHierarchy{
+		Path:              path,
+		Depth:             len(ancestors),
+		AncestorIds:       ancestors,
+		RootCompartmentId: tree.RootId,
+	}, nil
+}
+
+// getCompartmentSecurityZoneId returns the security zone of the compartment.
+// Subcompartments are protected by the zone of their nearest ancestor, unless
+// they are associated with a zone of their own.
+func getCompartmentSecurityZoneId(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := types.SafeString(h.Item.(identity.Compartment).Id)
+
+	zones, err := getSecurityZoneIdsByCompartment(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("getCompartmentSecurityZoneId", "api_error", err)
+		return nil, err
+	}
+	if zone, ok := zones[id]; ok {
+		return zone, nil
+	}
+
+	tree, err := getCompartmentTree(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("getCompartmentSecurityZoneId", "api_error", err)
+		return nil, err
+	}
+
+	// Ancestors are ordered from the root compartment
+	ancestors := tree.ancestors(id)
+	for i := len(ancestors) - 1; i >= 0; i-- {
+		if zone, ok := zones[ancestors[i]]; ok {
+			return zone, nil
+		}
+	}
+
+	return nil, nil
+}
+
 //// TRANSFORM FUNCTION
 
 func compartmentTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
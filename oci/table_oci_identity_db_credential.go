@@ -0,0 +1,144 @@
+package oci
+
+import (
+	"context"
+
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/identity"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableIdentityDbCredential(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_identity_db_credential",
+		Description:      "OCI Identity DB Credential",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			ParentHydrate: listUsers,
+			Hydrate:       listIdentityDbCredentials,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "user_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The OCID of the DB credential.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "user_id",
+				Description: "The OCID of the user the DB credential belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "user_name",
+				Description: "The name of the user the DB credential belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_state",
+				Description: "The credential's current state.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_created",
+				Description: "Date and time the DB credential was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeCreated.Time"),
+			},
+			{
+				Name:        "time_expires",
+				Description: "Date and time when this credential will expire.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeExpires.Time"),
+			},
+			{
+				Name:        "description",
+				Description: "The description you assign to the DB credential.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+type dbCredentialInfo struct {
+	identity.DbCredentialSummary
+	UserName string
+}
+
+//// LIST FUNCTION
+
+func listIdentityDbCredentials(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	user := h.Item.(identity.User)
+
+	// Return nil, if given user_id doesn't match
+	equalQuals := d.KeyColumnQuals
+	if equalQuals["user_id"] != nil && equalQuals["user_id"].GetStringValue() != *user.Id {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := identityService(ctx, d)
+	if err != nil {
+		logger.Error("oci_identity_db_credential.listIdentityDbCredentials", "connection_error", err)
+		return nil, err
+	}
+
+	request := identity.ListDbCredentialsRequest{
+		UserId: user.Id,
+		RequestMetadata: oci_common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.IdentityClient.ListDbCredentials(ctx, request)
+		if err != nil {
+			logger.Error("oci_identity_db_credential.listIdentityDbCredentials", "api_error", err)
+			return nil, err
+		}
+
+		for _, credential := range response.Items {
+			d.StreamLeafListItem(ctx, dbCredentialInfo{credential, *user.Name})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
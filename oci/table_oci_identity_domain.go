@@ -0,0 +1,345 @@
+package oci
+
+import (
+	"context"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/identity"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableIdentityDomain(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_identity_domain",
+		Description:      "OCI Identity Domain",
+		DefaultTransform: transform.FromCamel(),
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getIdentityDomain,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listIdentityDomains,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "display_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "home_region_url",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "is_hidden_on_login",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "license_type",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "lifecycle_state",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "type",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "url",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartmentList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "display_name",
+				Description: "The mutable display name of the identity domain.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The OCID of the identity domain.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "url",
+				Description: "Region agnostic domain URL, used as the domain_url key of the domain-scoped tables.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_state",
+				Description: "The current state of the identity domain.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_created",
+				Description: "Date and time the identity domain was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeCreated.Time"),
+			},
+			{
+				Name:        "description",
+				Description: "The identity domain description.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "home_region",
+				Description: "The home region for the identity domain.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "home_region_url",
+				Description: "Region specific domain URL.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_hidden_on_login",
+				Description: "Indicates whether the identity domain is hidden on the login screen.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "license_type",
+				Description: "The license type of the identity domain.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_details",
+				Description: "Any additional details about the current state of the identity domain.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the identity domain.",
+				Type:        proto.ColumnType_STRING,
+			},
+			// json fields
+			{
+				Name:        "replica_regions",
+				Description: "The regions the identity domain is replicated to.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// tags
+			{
+				Name:        "defined_tags",
+				Description: ColumnDescriptionDefinedTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "freeform_tags",
+				Description: ColumnDescriptionFreefromTags,
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(identityDomainTags),
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HomeRegion"),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listIdentityDomains(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Debug("oci_identity_domain.listIdentityDomains", "Compartment", compartment)
+
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given compartment_id doesn't match
+	if equalQuals["compartment_id"] != nil && compartment != equalQuals["compartment_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := identityService(ctx, d)
+	if err != nil {
+		logger.Error("oci_identity_domain.listIdentityDomains", "connection_error", err)
+		return nil, err
+	}
+
+	request := identity.ListDomainsRequest{
+		CompartmentId: types.String(compartment),
+		Limit:         types.Int(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	// Check for additional filters
+	if equalQuals["display_name"] != nil {
+		request.DisplayName = types.String(equalQuals["display_name"].GetStringValue())
+	}
+	if equalQuals["home_region_url"] != nil {
+		request.HomeRegionUrl = types.String(equalQuals["home_region_url"].GetStringValue())
+	}
+	if equalQuals["is_hidden_on_login"] != nil {
+		request.IsHiddenOnLogin = types.Bool(equalQuals["is_hidden_on_login"].GetBoolValue())
+	}
+	if equalQuals["license_type"] != nil {
+		request.LicenseType = types.String(equalQuals["license_type"].GetStringValue())
+	}
+	if equalQuals["lifecycle_state"] != nil {
+		request.LifecycleState = identity.DomainLifecycleStateEnum(equalQuals["lifecycle_state"].GetStringValue())
+	}
+	if equalQuals["type"] != nil {
+		request.Type = types.String(equalQuals["type"].GetStringValue())
+	}
+	if equalQuals["url"] != nil {
+		request.Url = types.String(equalQuals["url"].GetStringValue())
+	}
+
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < int64(*request.Limit) {
+			request.Limit = types.Int(int(*limit))
+		}
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.IdentityClient.ListDomains(ctx, request)
+		if err != nil {
+			logger.Error("oci_identity_domain.listIdentityDomains", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range response.Items {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTION
+
+func getIdentityDomain(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Debug("oci_identity_domain.getIdentityDomain", "Compartment", compartment)
+
+	// Restrict the api call to only root compartment
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		return nil, nil
+	}
+
+	id := d.KeyColumnQuals["id"].GetStringValue()
+
+	// handle empty id in get call
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := identityService(ctx, d)
+	if err != nil {
+		logger.Error("oci_identity_domain.getIdentityDomain", "connection_error", err)
+		return nil, err
+	}
+
+	request := identity.GetDomainRequest{
+		DomainId: types.String(id),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.IdentityClient.GetDomain(ctx, request)
+	if err != nil {
+		logger.Error("oci_identity_domain.getIdentityDomain", "api_error", err)
+		return nil, err
+	}
+
+	return response.Domain, nil
+}
+
+//// TRANSFORM FUNCTION
+
+func identityDomainTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	var freeformTags map[string]string
+	var definedTags map[string]map[string]interface{}
+
+	switch item := d.HydrateItem.(type) {
+	case identity.DomainSummary:
+		freeformTags = item.FreeformTags
+		definedTags = item.DefinedTags
+	case identity.Domain:
+		freeformTags = item.FreeformTags
+		definedTags = item.DefinedTags
+	}
+
+	var tags map[string]interface{}
+
+	if freeformTags != nil {
+		tags = map[string]interface{}{}
+		for k, v := range freeformTags {
+			tags[k] = v
+		}
+	}
+
+	if definedTags != nil {
+		if tags == nil {
+			tags = map[string]interface{}{}
+		}
+		for _, v := range definedTags {
+			for key, value := range v {
+				tags[key] = value
+			}
+		}
+	}
+
+	return tags, nil
+}
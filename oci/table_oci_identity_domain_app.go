@@ -0,0 +1,248 @@
+package oci
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// App attributes to request. clientSecret is deliberately never requested.
+const identityDomainAppAttributes = "displayName,description,active,clientType,isOAuthClient,isOAuthResource,isSamlServiceProvider,isLoginTarget,isManagedApp,isAliasApp,showInMyApps,loginMechanism,basedOnTemplate,allowedGrants,redirectUris,landingPageUrl,audience,name,ocid,compartmentOcid,domainOcid,meta"
+
+//// TABLE DEFINITION
+
+func tableIdentityDomainApp(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_identity_domain_app",
+		Description:      "OCI Identity Domain App",
+		DefaultTransform: transform.FromCamel(),
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"domain_url", "id"}),
+			Hydrate:    getIdentityDomainApp,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listIdentityDomainApps,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "domain_url",
+					Require: plugin.Required,
+				},
+				{
+					Name:      "display_name",
+					Require:   plugin.Optional,
+					Operators: []string{"=", "<>"},
+				},
+				{
+					Name:      "active",
+					Require:   plugin.Optional,
+					Operators: []string{"=", "<>"},
+				},
+				{
+					Name:      "is_oauth_client",
+					Require:   plugin.Optional,
+					Operators: []string{"=", "<>"},
+				},
+				{
+					Name:      "time_last_modified",
+					Require:   plugin.Optional,
+					Operators: []string{">", ">=", "<", "<="},
+				},
+			},
+		},
+		Columns: IdentityDomainColumns([]*plugin.Column{
+			{
+				Name:        "display_name",
+				Description: "The display name of the app.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The SCIM identifier of the app.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "name",
+				Description: "The unique name of the app.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the app.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "active",
+				Description: "Whether the app is active.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "based_on_template",
+				Description: "The name of the app template the app is based on.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BasedOnTemplate.Name"),
+			},
+			{
+				Name:        "client_type",
+				Description: "The type of OAuth client, e.g. confidential, public or trusted.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_oauth_client",
+				Description: "Whether the app acts as an OAuth client.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("IsOAuthClient"),
+			},
+			{
+				Name:        "is_oauth_resource",
+				Description: "Whether the app acts as an OAuth resource server.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("IsOAuthResource"),
+			},
+			{
+				Name:        "is_saml_service_provider",
+				Description: "Whether the app acts as a SAML service provider.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "is_login_target",
+				Description: "Whether sign-in to the app requires authentication by the identity domain.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "is_managed_app",
+				Description: "Whether the app is provisioned or synchronized by the identity domain.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "is_alias_app",
+				Description: "Whether the app is an alias of another app.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "show_in_my_apps",
+				Description: "Whether the app is shown on the My Apps page of its users.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "login_mechanism",
+				Description: "The protocol used to sign in to the app, e.g. OIDC or SAML.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "landing_page_url",
+				Description: "The URL users are taken to after signing in to the app.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "audience",
+				Description: "The base URI of the OAuth resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "allowed_grants",
+				Description: "The OAuth grant types the app is allowed to use.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "redirect_uris",
+				Description: "The OAuth redirect URIs of the app.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+			},
+		}),
+	}
+}
+
+type identityDomainApp struct {
+	identityDomainResource
+	DisplayName           *string            `json:"displayName"`
+	Name                  *string            `json:"name"`
+	Description           *string            `json:"description"`
+	Active                *bool              `json:"active"`
+	BasedOnTemplate       *identityDomainRef `json:"basedOnTemplate"`
+	ClientType            *string            `json:"clientType"`
+	IsOAuthClient         *bool              `json:"isOAuthClient"`
+	IsOAuthResource       *bool              `json:"isOAuthResource"`
+	IsSamlServiceProvider *bool              `json:"isSamlServiceProvider"`
+	IsLoginTarget         *bool              `json:"isLoginTarget"`
+	IsManagedApp          *bool              `json:"isManagedApp"`
+	IsAliasApp            *bool              `json:"isAliasApp"`
+	ShowInMyApps          *bool              `json:"showInMyApps"`
+	LoginMechanism        *string            `json:"loginMechanism"`
+	LandingPageUrl        *string            `json:"landingPageUrl"`
+	Audience              *string            `json:"audience"`
+	AllowedGrants         []string           `json:"allowedGrants"`
+	RedirectUris          []string           `json:"redirectUris"`
+}
+
+//// LIST FUNCTION
+
+func listIdentityDomainApps(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	domainUrl := d.KeyColumnQuals["domain_url"].GetStringValue()
+
+	query := url.Values{}
+	query.Set("attributes", identityDomainAppAttributes)
+
+	filter := buildIdentityDomainScimFilter(d.Quals, []identityDomainScimFilter{
+		{Column: "display_name", Attribute: "displayName"},
+		{Column: "active", Attribute: "active"},
+		{Column: "is_oauth_client", Attribute: "isOAuthClient"},
+		{Column: "time_last_modified", Attribute: "meta.lastModified"},
+	})
+	if filter != "" {
+		query.Set("filter", filter)
+	}
+
+	err := listIdentityDomainResources(ctx, d, domainUrl, "Apps", query, func(raw json.RawMessage) (bool, error) {
+		var app identityDomainApp
+		if err := json.Unmarshal(raw, &app); err != nil {
+			return false, err
+		}
+		d.StreamListItem(ctx, app)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.QueryStatus.RowsRemaining(ctx) != 0, nil
+	})
+	if err != nil {
+		logger.Error("oci_identity_domain_app.listIdentityDomainApps", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTION
+
+func getIdentityDomainApp(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	domainUrl := d.KeyColumnQuals["domain_url"].GetStringValue()
+	id := d.KeyColumnQuals["id"].GetStringValue()
+
+	// handle empty id in get call
+	if domainUrl == "" || id == "" {
+		return nil, nil
+	}
+
+	query := url.Values{}
+	query.Set("attributes", identityDomainAppAttributes)
+
+	var app identityDomainApp
+	if err := getIdentityDomainResource(ctx, d, domainUrl, "Apps", id, query, &app); err != nil {
+		logger.Error("oci_identity_domain_app.getIdentityDomainApp", "api_error", err)
+		return nil, err
+	}
+
+	return app, nil
+}
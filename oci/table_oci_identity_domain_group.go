@@ -0,0 +1,180 @@
+package oci
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableIdentityDomainGroup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_identity_domain_group",
+		Description:      "OCI Identity Domain Group",
+		DefaultTransform: transform.FromCamel(),
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"domain_url", "id"}),
+			Hydrate:    getIdentityDomainGroup,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listIdentityDomainGroups,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "domain_url",
+					Require: plugin.Required,
+				},
+				{
+					Name:      "display_name",
+					Require:   plugin.Optional,
+					Operators: []string{"=", "<>"},
+				},
+				{
+					Name:      "external_id",
+					Require:   plugin.Optional,
+					Operators: []string{"=", "<>"},
+				},
+				{
+					Name:      "time_last_modified",
+					Require:   plugin.Optional,
+					Operators: []string{">", ">=", "<", "<="},
+				},
+			},
+		},
+		Columns: IdentityDomainColumns([]*plugin.Column{
+			{
+				Name:        "display_name",
+				Description: "The group display name, unique within the identity domain.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The SCIM identifier of the group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The group description.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GroupExtension.Description"),
+			},
+			{
+				Name:        "external_id",
+				Description: "The identifier of the group in the provisioning client.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_mechanism",
+				Description: "The mechanism by which the group was created, e.g. api, import or sync.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GroupExtension.CreationMechanism"),
+			},
+			{
+				Name:        "member_count",
+				Description: "The number of direct members of the group.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.From(identityDomainGroupMemberCount),
+			},
+			{
+				Name:        "members",
+				Description: "The direct members of the group.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+			},
+		}),
+	}
+}
+
+type identityDomainGroup struct {
+	identityDomainResource
+	DisplayName    *string                     `json:"displayName"`
+	ExternalId     *string                     `json:"externalId"`
+	Members        []identityDomainGroupMember `json:"members"`
+	GroupExtension struct {
+		Description       *string `json:"description"`
+		CreationMechanism *string `json:"creationMechanism"`
+	} `json:"urn:ietf:params:scim:schemas:oracle:idcs:extension:group:Group"`
+}
+
+type identityDomainGroupMember struct {
+	identityDomainRef
+	DateAdded *string `json:"dateAdded"`
+}
+
+//// LIST FUNCTION
+
+func listIdentityDomainGroups(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	domainUrl := d.KeyColumnQuals["domain_url"].GetStringValue()
+
+	// members are only returned on request
+	query := url.Values{}
+	query.Set("attributeSets", "all")
+
+	filter := buildIdentityDomainScimFilter(d.Quals, []identityDomainScimFilter{
+		{Column: "display_name", Attribute: "displayName"},
+		{Column: "external_id", Attribute: "externalId"},
+		{Column: "time_last_modified", Attribute: "meta.lastModified"},
+	})
+	if filter != "" {
+		query.Set("filter", filter)
+	}
+
+	err := listIdentityDomainResources(ctx, d, domainUrl, "Groups", query, func(raw json.RawMessage) (bool, error) {
+		var group identityDomainGroup
+		if err := json.Unmarshal(raw, &group); err != nil {
+			return false, err
+		}
+		d.StreamListItem(ctx, group)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.QueryStatus.RowsRemaining(ctx) != 0, nil
+	})
+	if err != nil {
+		logger.Error("oci_identity_domain_group.listIdentityDomainGroups", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTION
+
+func getIdentityDomainGroup(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	domainUrl := d.KeyColumnQuals["domain_url"].GetStringValue()
+	id := d.KeyColumnQuals["id"].GetStringValue()
+
+	// handle empty id in get call
+	if domainUrl == "" || id == "" {
+		return nil, nil
+	}
+
+	query := url.Values{}
+	query.Set("attributeSets", "all")
+
+	var group identityDomainGroup
+	if err := getIdentityDomainResource(ctx, d, domainUrl, "Groups", id, query, &group); err != nil {
+		logger.Error("oci_identity_domain_group.getIdentityDomainGroup", "api_error", err)
+		return nil, err
+	}
+
+	return group, nil
+}
+
+//// TRANSFORM FUNCTION
+
+func identityDomainGroupMemberCount(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	return len(d.HydrateItem.(identityDomainGroup).Members), nil
+}
@@ -0,0 +1,189 @@
+package oci
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableIdentityDomainGroupMembership(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_identity_domain_group_membership",
+		Description:      "OCI Identity Domain Group Membership",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			Hydrate: listIdentityDomainGroupMemberships,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "domain_url",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "group_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "group_display_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "member_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "group_id",
+				Description: "The SCIM identifier of the group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "group_display_name",
+				Description: "The display name of the group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "group_ocid",
+				Description: "The OCID of the group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "member_id",
+				Description: "The SCIM identifier of the member.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Member.Value"),
+			},
+			{
+				Name:        "member_type",
+				Description: "The type of the member, e.g. User or App.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Member.Type"),
+			},
+			{
+				Name:        "member_display_name",
+				Description: "The display name of the member.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Member.Display"),
+			},
+			{
+				Name:        "member_name",
+				Description: "The user name of the member, if the member is a user.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Member.Name"),
+			},
+			{
+				Name:        "member_ocid",
+				Description: "The OCID of the member.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Member.Ocid"),
+			},
+			{
+				Name:        "time_added",
+				Description: "The date and time the member was added to the group.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Member.DateAdded"),
+			},
+			{
+				Name:        "domain_url",
+				Description: "The URL of the identity domain, e.g. https://idcs-abc123.identity.oraclecloud.com:443.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("domain_url"),
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Member.Display"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+type identityDomainGroupMembershipInfo struct {
+	GroupId          *string
+	GroupDisplayName *string
+	GroupOcid        *string
+	CompartmentId    *string
+	Member           identityDomainGroupMember
+}
+
+//// LIST FUNCTION
+
+func listIdentityDomainGroupMemberships(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	equalQuals := d.KeyColumnQuals
+	domainUrl := equalQuals["domain_url"].GetStringValue()
+
+	query := url.Values{}
+	query.Set("attributes", "displayName,members")
+
+	// Narrow down the groups to read, the member filter is applied again below
+	// since a group is returned with all of its members
+	filter := buildIdentityDomainScimFilter(d.Quals, []identityDomainScimFilter{
+		{Column: "group_id", Attribute: "id"},
+		{Column: "group_display_name", Attribute: "displayName"},
+		{Column: "member_id", Attribute: "members.value"},
+	})
+	if filter != "" {
+		query.Set("filter", filter)
+	}
+
+	err := listIdentityDomainResources(ctx, d, domainUrl, "Groups", query, func(raw json.RawMessage) (bool, error) {
+		var group identityDomainGroup
+		if err := json.Unmarshal(raw, &group); err != nil {
+			return false, err
+		}
+
+		for _, member := range group.Members {
+			// Skip, if given member_id doesn't match
+			if equalQuals["member_id"] != nil && equalQuals["member_id"].GetStringValue() != types.SafeString(member.Value) {
+				continue
+			}
+
+			d.StreamListItem(ctx, identityDomainGroupMembershipInfo{
+				GroupId:          group.Id,
+				GroupDisplayName: group.DisplayName,
+				GroupOcid:        group.Ocid,
+				CompartmentId:    group.CompartmentOcid,
+				Member:           member,
+			})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		logger.Error("oci_identity_domain_group_membership.listIdentityDomainGroupMemberships", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,207 @@
+package oci
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// SCIM attribute path of the MFA user extension
+const identityDomainMfaExtension = "urn:ietf:params:scim:schemas:oracle:idcs:extension:mfa:User"
+
+//// TABLE DEFINITION
+
+func tableIdentityDomainMfaEnrollment(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_identity_domain_mfa_enrollment",
+		Description:      "OCI Identity Domain MFA Enrollment",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			Hydrate: listIdentityDomainMfaEnrollments,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "domain_url",
+					Require: plugin.Required,
+				},
+				{
+					Name:      "user_name",
+					Require:   plugin.Optional,
+					Operators: []string{"=", "<>"},
+				},
+				{
+					Name:      "mfa_status",
+					Require:   plugin.Optional,
+					Operators: []string{"=", "<>"},
+				},
+				{
+					Name:      "active",
+					Require:   plugin.Optional,
+					Operators: []string{"=", "<>"},
+				},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "user_name",
+				Description: "The user name, unique within the identity domain.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "user_id",
+				Description: "The SCIM identifier of the user.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "user_ocid",
+				Description: "The OCID of the user.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Ocid"),
+			},
+			{
+				Name:        "active",
+				Description: "Whether the user is active.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "mfa_status",
+				Description: "The multi-factor authentication enrollment status of the user, e.g. ENROLLED or DISABLED.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MfaExtension.MfaStatus"),
+			},
+			{
+				Name:        "time_mfa_enabled",
+				Description: "The date and time the user enabled multi-factor authentication.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("MfaExtension.MfaEnabledOn"),
+			},
+			{
+				Name:        "preferred_authentication_factor",
+				Description: "The preferred authentication factor of the user, e.g. TOTP, PUSH or SMS.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MfaExtension.PreferredAuthenticationFactor"),
+			},
+			{
+				Name:        "preferred_authentication_method",
+				Description: "The preferred authentication method of the user.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MfaExtension.PreferredAuthenticationMethod"),
+			},
+			{
+				Name:        "preferred_device",
+				Description: "The preferred authentication device of the user.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("MfaExtension.PreferredDevice"),
+			},
+			{
+				Name:        "login_attempts",
+				Description: "The number of failed multi-factor authentication attempts of the user.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("MfaExtension.LoginAttempts"),
+			},
+			{
+				Name:        "device_count",
+				Description: "The number of authentication devices enrolled by the user.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.From(identityDomainMfaDeviceCount),
+			},
+			{
+				Name:        "bypass_code_count",
+				Description: "The number of bypass codes generated for the user.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.From(identityDomainMfaBypassCodeCount),
+			},
+			{
+				Name:        "devices",
+				Description: "The authentication devices enrolled by the user.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("MfaExtension.Devices"),
+			},
+			{
+				Name:        "trusted_user_agents",
+				Description: "The browsers and apps the user has marked as trusted.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("MfaExtension.TrustedUserAgents"),
+			},
+			{
+				Name:        "domain_url",
+				Description: "The URL of the identity domain, e.g. https://idcs-abc123.identity.oraclecloud.com:443.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("domain_url"),
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UserName"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CompartmentOcid"),
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listIdentityDomainMfaEnrollments(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	domainUrl := d.KeyColumnQuals["domain_url"].GetStringValue()
+
+	query := url.Values{}
+	query.Set("attributes", "userName,active,ocid,compartmentOcid,"+identityDomainMfaExtension)
+
+	filter := buildIdentityDomainScimFilter(d.Quals, []identityDomainScimFilter{
+		{Column: "user_name", Attribute: "userName"},
+		{Column: "mfa_status", Attribute: identityDomainMfaExtension + ":mfaStatus"},
+		{Column: "active", Attribute: "active"},
+	})
+	if filter != "" {
+		query.Set("filter", filter)
+	}
+
+	err := listIdentityDomainResources(ctx, d, domainUrl, "Users", query, func(raw json.RawMessage) (bool, error) {
+		var user identityDomainUser
+		if err := json.Unmarshal(raw, &user); err != nil {
+			return false, err
+		}
+		d.StreamListItem(ctx, user)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.QueryStatus.RowsRemaining(ctx) != 0, nil
+	})
+	if err != nil {
+		logger.Error("oci_identity_domain_mfa_enrollment.listIdentityDomainMfaEnrollments", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func identityDomainMfaDeviceCount(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	return len(d.HydrateItem.(identityDomainUser).MfaExtension.Devices), nil
+}
+
+func identityDomainMfaBypassCodeCount(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	return len(d.HydrateItem.(identityDomainUser).MfaExtension.BypassCodes), nil
+}
@@ -0,0 +1,254 @@
+package oci
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableIdentityDomainPasswordPolicy(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_identity_domain_password_policy",
+		Description:      "OCI Identity Domain Password Policy",
+		DefaultTransform: transform.FromCamel(),
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"domain_url", "id"}),
+			Hydrate:    getIdentityDomainPasswordPolicy,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listIdentityDomainPasswordPolicies,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "domain_url",
+					Require: plugin.Required,
+				},
+				{
+					Name:      "name",
+					Require:   plugin.Optional,
+					Operators: []string{"=", "<>"},
+				},
+			},
+		},
+		Columns: IdentityDomainColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the password policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The SCIM identifier of the password policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the password policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "priority",
+				Description: "The priority of the password policy, the lowest value takes precedence.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "password_strength",
+				Description: "The strength of the password policy, e.g. Simple, Standard or Custom.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "min_length",
+				Description: "The minimum number of characters in a password.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "max_length",
+				Description: "The maximum number of characters in a password.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "min_lower_case",
+				Description: "The minimum number of lowercase characters in a password.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "min_upper_case",
+				Description: "The minimum number of uppercase characters in a password.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "min_numerals",
+				Description: "The minimum number of numeric characters in a password.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "min_special_chars",
+				Description: "The minimum number of special characters in a password.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "min_unique_chars",
+				Description: "The minimum number of unique characters in a password.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "max_repeated_chars",
+				Description: "The maximum number of repeated characters allowed in a password.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "password_expires_after",
+				Description: "The number of days after which a password expires.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "password_expire_warning",
+				Description: "The number of days before expiry that users are warned.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "num_passwords_in_history",
+				Description: "The number of previous passwords that cannot be reused.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "max_incorrect_attempts",
+				Description: "The maximum number of failed sign-in attempts before the account is locked.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "lockout_duration",
+				Description: "The time, in minutes, that an account stays locked.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "user_name_disallowed",
+				Description: "Whether the password may not contain the user name.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "first_name_disallowed",
+				Description: "Whether the password may not contain the first name.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "last_name_disallowed",
+				Description: "Whether the password may not contain the last name.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "dictionary_word_disallowed",
+				Description: "Whether the password may not be a dictionary word.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "force_password_reset",
+				Description: "Whether users must reset their password after it is set by an administrator.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "groups",
+				Description: "The groups the password policy applies to.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+type identityDomainPasswordPolicy struct {
+	identityDomainResource
+	Name                     *string             `json:"name"`
+	Description              *string             `json:"description"`
+	Priority                 *int                `json:"priority"`
+	PasswordStrength         *string             `json:"passwordStrength"`
+	MinLength                *int                `json:"minLength"`
+	MaxLength                *int                `json:"maxLength"`
+	MinLowerCase             *int                `json:"minLowerCase"`
+	MinUpperCase             *int                `json:"minUpperCase"`
+	MinNumerals              *int                `json:"minNumerals"`
+	MinSpecialChars          *int                `json:"minSpecialChars"`
+	MinUniqueChars           *int                `json:"minUniqueChars"`
+	MaxRepeatedChars         *int                `json:"maxRepeatedChars"`
+	PasswordExpiresAfter     *int                `json:"passwordExpiresAfter"`
+	PasswordExpireWarning    *int                `json:"passwordExpireWarning"`
+	NumPasswordsInHistory    *int                `json:"numPasswordsInHistory"`
+	MaxIncorrectAttempts     *int                `json:"maxIncorrectAttempts"`
+	LockoutDuration          *int                `json:"lockoutDuration"`
+	UserNameDisallowed       *bool               `json:"userNameDisallowed"`
+	FirstNameDisallowed      *bool               `json:"firstNameDisallowed"`
+	LastNameDisallowed       *bool               `json:"lastNameDisallowed"`
+	DictionaryWordDisallowed *bool               `json:"dictionaryWordDisallowed"`
+	ForcePasswordReset       *bool               `json:"forcePasswordReset"`
+	Groups                   []identityDomainRef `json:"groups"`
+}
+
+//// LIST FUNCTION
+
+func listIdentityDomainPasswordPolicies(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	domainUrl := d.KeyColumnQuals["domain_url"].GetStringValue()
+
+	query := url.Values{}
+	query.Set("attributeSets", "all")
+
+	filter := buildIdentityDomainScimFilter(d.Quals, []identityDomainScimFilter{
+		{Column: "name", Attribute: "name"},
+	})
+	if filter != "" {
+		query.Set("filter", filter)
+	}
+
+	err := listIdentityDomainResources(ctx, d, domainUrl, "PasswordPolicies", query, func(raw json.RawMessage) (bool, error) {
+		var policy identityDomainPasswordPolicy
+		if err := json.Unmarshal(raw, &policy); err != nil {
+			return false, err
+		}
+		d.StreamListItem(ctx, policy)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.QueryStatus.RowsRemaining(ctx) != 0, nil
+	})
+	if err != nil {
+		logger.Error("oci_identity_domain_password_policy.listIdentityDomainPasswordPolicies", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTION
+
+func getIdentityDomainPasswordPolicy(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	domainUrl := d.KeyColumnQuals["domain_url"].GetStringValue()
+	id := d.KeyColumnQuals["id"].GetStringValue()
+
+	// handle empty id in get call
+	if domainUrl == "" || id == "" {
+		return nil, nil
+	}
+
+	query := url.Values{}
+	query.Set("attributeSets", "all")
+
+	var policy identityDomainPasswordPolicy
+	if err := getIdentityDomainResource(ctx, d, domainUrl, "PasswordPolicies", id, query, &policy); err != nil {
+		logger.Error("oci_identity_domain_password_policy.getIdentityDomainPasswordPolicy", "api_error", err)
+		return nil, err
+	}
+
+	return policy, nil
+}
@@ -0,0 +1,315 @@
+package oci
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableIdentityDomainUser(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_identity_domain_user",
+		Description:      "OCI Identity Domain User",
+		DefaultTransform: transform.FromCamel(),
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"domain_url", "id"}),
+			Hydrate:    getIdentityDomainUser,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listIdentityDomainUsers,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "domain_url",
+					Require: plugin.Required,
+				},
+				{
+					Name:      "user_name",
+					Require:   plugin.Optional,
+					Operators: []string{"=", "<>"},
+				},
+				{
+					Name:      "display_name",
+					Require:   plugin.Optional,
+					Operators: []string{"=", "<>"},
+				},
+				{
+					Name:      "external_id",
+					Require:   plugin.Optional,
+					Operators: []string{"=", "<>"},
+				},
+				{
+					Name:      "active",
+					Require:   plugin.Optional,
+					Operators: []string{"=", "<>"},
+				},
+				{
+					Name:      "time_last_modified",
+					Require:   plugin.Optional,
+					Operators: []string{">", ">=", "<", "<="},
+				},
+			},
+		},
+		Columns: IdentityDomainColumns([]*plugin.Column{
+			{
+				Name:        "user_name",
+				Description: "The user name, unique within the identity domain.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The SCIM identifier of the user.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "display_name",
+				Description: "The name of the user, suitable for display to end-users.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "active",
+				Description: "Whether the user is active.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "given_name",
+				Description: "The given (first) name of the user.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name.GivenName"),
+			},
+			{
+				Name:        "family_name",
+				Description: "The family (last) name of the user.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name.FamilyName"),
+			},
+			{
+				Name:        "primary_email",
+				Description: "The primary email address of the user.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(identityDomainUserPrimaryEmail),
+			},
+			{
+				Name:        "external_id",
+				Description: "The identifier of the user in the provisioning client.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "user_type",
+				Description: "Identifies the organization-to-user relationship.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_federated_user",
+				Description: "Whether the user is federated.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("UserExtension.IsFederatedUser"),
+			},
+			{
+				Name:        "creation_mechanism",
+				Description: "The mechanism by which the user was created, e.g. api, import or sync.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UserExtension.CreationMechanism"),
+			},
+			{
+				Name:        "is_locked",
+				Description: "Whether the user account is locked.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("UserStateExtension.Locked.On"),
+			},
+			{
+				Name:        "time_last_successful_login",
+				Description: "The date and time of the last successful login of the user.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UserStateExtension.LastSuccessfulLoginDate"),
+			},
+			{
+				Name:        "time_last_failed_login",
+				Description: "The date and time of the last failed login of the user.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UserStateExtension.LastFailedLoginDate"),
+			},
+			{
+				Name:        "time_password_last_set",
+				Description: "The date and time the password of the user was last set.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("PasswordStateExtension.LastSuccessfulSetDate"),
+			},
+			{
+				Name:        "is_password_expired",
+				Description: "Whether the password of the user has expired.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("PasswordStateExtension.Expired"),
+			},
+			{
+				Name:        "mfa_status",
+				Description: "The multi-factor authentication enrollment status of the user.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MfaExtension.MfaStatus"),
+			},
+			{
+				Name:        "emails",
+				Description: "The email addresses of the user.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "phone_numbers",
+				Description: "The phone numbers of the user.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "groups",
+				Description: "The groups the user belongs to, either directly or through nested groups.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "capabilities",
+				Description: "The credential types the user is allowed to use.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CapabilitiesExtension"),
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UserName"),
+			},
+		}),
+	}
+}
+
+type identityDomainUser struct {
+	identityDomainResource
+	UserName    *string `json:"userName"`
+	DisplayName *string `json:"displayName"`
+	ExternalId  *string `json:"externalId"`
+	UserType    *string `json:"userType"`
+	Active      *bool   `json:"active"`
+	Name        struct {
+		GivenName  *string `json:"givenName"`
+		FamilyName *string `json:"familyName"`
+		Formatted  *string `json:"formatted"`
+	} `json:"name"`
+	Emails []struct {
+		Value    *string `json:"value"`
+		Type     *string `json:"type"`
+		Primary  *bool   `json:"primary"`
+		Verified *bool   `json:"verified"`
+	} `json:"emails"`
+	PhoneNumbers []struct {
+		Value   *string `json:"value"`
+		Type    *string `json:"type"`
+		Primary *bool   `json:"primary"`
+	} `json:"phoneNumbers"`
+	Groups        []identityDomainRef `json:"groups"`
+	UserExtension struct {
+		IsFederatedUser   *bool   `json:"isFederatedUser"`
+		CreationMechanism *string `json:"creationMechanism"`
+	} `json:"urn:ietf:params:scim:schemas:oracle:idcs:extension:user:User"`
+	UserStateExtension struct {
+		LastSuccessfulLoginDate *string `json:"lastSuccessfulLoginDate"`
+		LastFailedLoginDate     *string `json:"lastFailedLoginDate"`
+		Locked                  struct {
+			On *bool `json:"on"`
+		} `json:"locked"`
+	} `json:"urn:ietf:params:scim:schemas:oracle:idcs:extension:userState:User"`
+	PasswordStateExtension struct {
+		LastSuccessfulSetDate *string `json:"lastSuccessfulSetDate"`
+		Expired               *bool   `json:"expired"`
+	} `json:"urn:ietf:params:scim:schemas:oracle:idcs:extension:passwordState:User"`
+	MfaExtension          identityDomainUserMfa `json:"urn:ietf:params:scim:schemas:oracle:idcs:extension:mfa:User"`
+	CapabilitiesExtension map[string]bool       `json:"urn:ietf:params:scim:schemas:oracle:idcs:extension:capabilities:User"`
+}
+
+type identityDomainUserMfa struct {
+	MfaStatus                     *string             `json:"mfaStatus"`
+	MfaEnabledOn                  *string             `json:"mfaEnabledOn"`
+	PreferredAuthenticationFactor *string             `json:"preferredAuthenticationFactor"`
+	PreferredAuthenticationMethod *string             `json:"preferredAuthenticationMethod"`
+	PreferredDevice               *identityDomainRef  `json:"preferredDevice"`
+	LoginAttempts                 *int                `json:"loginAttempts"`
+	Devices                       []identityDomainRef `json:"devices"`
+	BypassCodes                   []identityDomainRef `json:"bypassCodes"`
+	TrustedUserAgents             []identityDomainRef `json:"trustedUserAgents"`
+}
+
+//// LIST FUNCTION
+
+func listIdentityDomainUsers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	domainUrl := d.KeyColumnQuals["domain_url"].GetStringValue()
+
+	query := url.Values{}
+	query.Set("attributeSets", "all")
+
+	filter := buildIdentityDomainScimFilter(d.Quals, []identityDomainScimFilter{
+		{Column: "user_name", Attribute: "userName"},
+		{Column: "display_name", Attribute: "displayName"},
+		{Column: "external_id", Attribute: "externalId"},
+		{Column: "active", Attribute: "active"},
+		{Column: "time_last_modified", Attribute: "meta.lastModified"},
+	})
+	if filter != "" {
+		query.Set("filter", filter)
+	}
+
+	err := listIdentityDomainResources(ctx, d, domainUrl, "Users", query, func(raw json.RawMessage) (bool, error) {
+		var user identityDomainUser
+		if err := json.Unmarshal(raw, &user); err != nil {
+			return false, err
+		}
+		d.StreamListItem(ctx, user)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.QueryStatus.RowsRemaining(ctx) != 0, nil
+	})
+	if err != nil {
+		logger.Error("oci_identity_domain_user.listIdentityDomainUsers", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTION
+
+func getIdentityDomainUser(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	domainUrl := d.KeyColumnQuals["domain_url"].GetStringValue()
+	id := d.KeyColumnQuals["id"].GetStringValue()
+
+	// handle empty id in get call
+	if domainUrl == "" || id == "" {
+		return nil, nil
+	}
+
+	query := url.Values{}
+	query.Set("attributeSets", "all")
+
+	var user identityDomainUser
+	if err := getIdentityDomainResource(ctx, d, domainUrl, "Users", id, query, &user); err != nil {
+		logger.Error("oci_identity_domain_user.getIdentityDomainUser", "api_error", err)
+		return nil, err
+	}
+
+	return user, nil
+}
+
+//// TRANSFORM FUNCTION
+
+func identityDomainUserPrimaryEmail(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	user := d.HydrateItem.(identityDomainUser)
+	for _, email := range user.Emails {
+		if email.Primary != nil && *email.Primary {
+			return email.Value, nil
+		}
+	}
+	return nil, nil
+}
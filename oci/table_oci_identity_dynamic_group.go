@@ -67,6 +67,18 @@ func tableIdentityDynamicGroup(_ context.Context) *plugin.Table {
 				Description: "A rule string that defines which instance certificates will be matched.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "matching_rule_parsed",
+				Description: "The matching rule parsed into its ANY and ALL operators and attribute conditions.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("MatchingRule").Transform(parseDynamicGroupMatchingRule),
+			},
+			{
+				Name:        "matching_rule_parse_error",
+				Description: "The reason the matching rule could not be parsed.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MatchingRule").Transform(dynamicGroupMatchingRuleParseError),
+			},
 			{
 				Name:        "time_created",
 				Description: "Date and time the group was created, in the format defined by RFC3339.",
@@ -235,3 +247,18 @@ func dynamicGroupTags(_ context.Context, d *transform.TransformData) (interface{
 
 	return tags, nil
 }
+
+func parseDynamicGroupMatchingRule(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	rule, err := parseMatchingRule(types.ToString(d.Value))
+	if err != nil {
+		return nil, nil
+	}
+	return rule, nil
+}
+
+func dynamicGroupMatchingRuleParseError(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if _, err := parseMatchingRule(types.ToString(d.Value)); err != nil {
+		return err.Error(), nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,473 @@
+package oci
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/oracle/oci-go-sdk/v65/functions"
+	"github.com/oracle/oci-go-sdk/v65/identity"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableIdentityDynamicGroupMember(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_identity_dynamic_group_member",
+		Description:      "OCI Identity Dynamic Group Member",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			Hydrate: listIdentityDynamicGroupMembers,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "dynamic_group_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "member_type",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "dynamic_group_name",
+				Description: "The name of the dynamic group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "dynamic_group_id",
+				Description: "The OCID of the dynamic group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "member_type",
+				Description: "The type of resource matched by the rule: instance or fnfunc. Null on rows reporting a rule that could not be fully evaluated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "member_id",
+				Description: "The OCID of the matched resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "member_name",
+				Description: "The display name of the matched resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "member_lifecycle_state",
+				Description: "The current state of the matched resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "matching_rule",
+				Description: "The matching rule of the dynamic group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "evaluation_error",
+				Description: "Why the matching rule could not be fully evaluated: the error parsing it, or the attributes and resource types it uses that are not evaluated. Set on a row without a member, returned once for each such dynamic group from the home region of the tenancy.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MemberName"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+type dynamicGroupMemberInfo struct {
+	DynamicGroupId       *string
+	DynamicGroupName     *string
+	MatchingRule         *string
+	MemberType           string
+	MemberId             *string
+	MemberName           *string
+	MemberLifecycleState *string
+	EvaluationError      *string
+	Region               string
+	CompartmentId        *string
+}
+
+// dynamicGroupRule is an active dynamic group along with its parsed matching
+// rule, or the error parsing it
+type dynamicGroupRule struct {
+	Group      identity.DynamicGroup
+	Rule       *matchingRule
+	ParseError error
+}
+
+//// LIST FUNCTION
+
+func listIdentityDynamicGroupMembers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given compartment_id doesn't match
+	if equalQuals["compartment_id"] != nil && compartment != equalQuals["compartment_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	rules, err := listDynamicGroupRules(ctx, d)
+	if err != nil {
+		logger.Error("oci_identity_dynamic_group_member.listIdentityDynamicGroupMembers", "api_error", err)
+		return nil, err
+	}
+
+	// Only evaluate the dynamic groups asked for
+	if equalQuals["dynamic_group_id"] != nil {
+		var filtered []dynamicGroupRule
+		for _, rule := range rules {
+			if types.SafeString(rule.Group.Id) == equalQuals["dynamic_group_id"].GetStringValue() {
+				filtered = append(filtered, rule)
+			}
+		}
+		rules = filtered
+	}
+
+	memberType := ""
+	if equalQuals["member_type"] != nil {
+		memberType = equalQuals["member_type"].GetStringValue()
+	}
+
+	// Dynamic groups live in the tenancy, so rules that cannot be fully
+	// evaluated are reported once, from the home region and the tenancy
+	// compartment
+	errorRegion, err := getDynamicGroupErrorRegion(ctx, d)
+	if err != nil {
+		logger.Error("oci_identity_dynamic_group_member.listIdentityDynamicGroupMembers", "api_error", err)
+		return nil, err
+	}
+
+	var parsed []dynamicGroupRule
+	for _, rule := range rules {
+		if rule.ParseError == nil {
+			parsed = append(parsed, rule)
+		}
+
+		evaluationError := dynamicGroupRuleEvaluationError(rule)
+		if evaluationError == "" || memberType != "" || region != errorRegion || compartment != types.SafeString(rule.Group.CompartmentId) {
+			continue
+		}
+		d.StreamListItem(ctx, dynamicGroupMemberInfo{
+			DynamicGroupId:   rule.Group.Id,
+			DynamicGroupName: rule.Group.Name,
+			MatchingRule:     rule.Group.MatchingRule,
+			EvaluationError:  types.String(evaluationError),
+			Region:           region,
+			CompartmentId:    rule.Group.CompartmentId,
+		})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+	rules = parsed
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	// Instances are matched by instance.* attributes and functions by
+	// resource.* attributes, while tags apply to both
+	var matchInstances, matchFunctions bool
+	for _, rule := range rules {
+		matchInstances = matchInstances || rule.Rule.references("instance.") || rule.Rule.references("tag.")
+		matchFunctions = matchFunctions || rule.Rule.references("resource.") || rule.Rule.references("tag.")
+	}
+
+	if matchInstances && (memberType == "" || memberType == "instance") {
+		if err := streamInstanceDynamicGroupMembers(ctx, d, region, compartment, rules); err != nil {
+			logger.Error("oci_identity_dynamic_group_member.listIdentityDynamicGroupMembers", "api_error", err)
+			return nil, err
+		}
+	}
+	if matchFunctions && (memberType == "" || memberType == "fnfunc") {
+		if err := streamFunctionDynamicGroupMembers(ctx, d, region, compartment, rules); err != nil {
+			logger.Error("oci_identity_dynamic_group_member.listIdentityDynamicGroupMembers", "api_error", err)
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+func streamInstanceDynamicGroupMembers(ctx context.Context, d *plugin.QueryData, region string, compartment string, rules []dynamicGroupRule) error {
+	// Create Session
+	session, err := coreComputeService(ctx, d, region)
+	if err != nil {
+		return err
+	}
+
+	request := core.ListInstancesRequest{
+		CompartmentId: types.String(compartment),
+		Limit:         types.Int(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.ComputeClient.ListInstances(ctx, request)
+		if err != nil {
+			return err
+		}
+
+		for _, instance := range response.Items {
+			if instance.LifecycleState == core.InstanceLifecycleStateTerminated {
+				continue
+			}
+
+			instance := instance
+			attributes := func(attribute string) (string, bool) {
+				switch attribute {
+				case "instance.id":
+					return types.SafeString(instance.Id), true
+				case "instance.compartment.id":
+					return types.SafeString(instance.CompartmentId), true
+				}
+				return matchingRuleTagAttributes(attribute, instance.DefinedTags)
+			}
+
+			for _, rule := range rules {
+				if !rule.Rule.evaluate(attributes) {
+					continue
+				}
+				d.StreamListItem(ctx, dynamicGroupMemberInfo{
+					DynamicGroupId:       rule.Group.Id,
+					DynamicGroupName:     rule.Group.Name,
+					MatchingRule:         rule.Group.MatchingRule,
+					MemberType:           "instance",
+					MemberId:             instance.Id,
+					MemberName:           instance.DisplayName,
+					MemberLifecycleState: types.String(string(instance.LifecycleState)),
+					Region:               region,
+					CompartmentId:        instance.CompartmentId,
+				})
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return nil
+				}
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil
+}
+
+func streamFunctionDynamicGroupMembers(ctx context.Context, d *plugin.QueryData, region string, compartment string, rules []dynamicGroupRule) error {
+	// Create Session
+	session, err := functionsManagementService(ctx, d, region)
+	if err != nil {
+		return err
+	}
+
+	var applications []functions.ApplicationSummary
+	applicationsRequest := functions.ListApplicationsRequest{
+		CompartmentId: types.String(compartment),
+		Limit:         types.Int(50),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+	for {
+		response, err := session.FunctionsManagementClient.ListApplications(ctx, applicationsRequest)
+		if err != nil {
+			return err
+		}
+		applications = append(applications, response.Items...)
+		if response.OpcNextPage == nil {
+			break
+		}
+		applicationsRequest.Page = response.OpcNextPage
+	}
+
+	for _, application := range applications {
+		request := functions.ListFunctionsRequest{
+			ApplicationId: application.Id,
+			Limit:         types.Int(50),
+			RequestMetadata: common.RequestMetadata{
+				RetryPolicy: getDefaultRetryPolicy(d.Connection),
+			},
+		}
+
+		pagesLeft := true
+		for pagesLeft {
+			response, err := session.FunctionsManagementClient.ListFunctions(ctx, request)
+			if err != nil {
+				return err
+			}
+
+			for _, function := range response.Items {
+				function := function
+				attributes := func(attribute string) (string, bool) {
+					switch attribute {
+					case "resource.type":
+						return "fnfunc", true
+					case "resource.id":
+						return types.SafeString(function.Id), true
+					case "resource.compartment.id":
+						return types.SafeString(function.CompartmentId), true
+					}
+					return matchingRuleTagAttributes(attribute, function.DefinedTags)
+				}
+
+				for _, rule := range rules {
+					if !rule.Rule.evaluate(attributes) {
+						continue
+					}
+					d.StreamListItem(ctx, dynamicGroupMemberInfo{
+						DynamicGroupId:       rule.Group.Id,
+						DynamicGroupName:     rule.Group.Name,
+						MatchingRule:         rule.Group.MatchingRule,
+						MemberType:           "fnfunc",
+						MemberId:             function.Id,
+						MemberName:           function.DisplayName,
+						MemberLifecycleState: types.String(string(function.LifecycleState)),
+						Region:               region,
+						CompartmentId:        function.CompartmentId,
+					})
+
+					// Context can be cancelled due to manual cancellation or the limit has been hit
+					if d.QueryStatus.RowsRemaining(ctx) == 0 {
+						return nil
+					}
+				}
+			}
+			if response.OpcNextPage != nil {
+				request.Page = response.OpcNextPage
+			} else {
+				pagesLeft = false
+			}
+		}
+	}
+
+	return nil
+}
+
+// getDynamicGroupErrorRegion returns the region the rows of rules that cannot
+// be fully evaluated are reported from: the home region, or the first region
+// of the connection if the home region isn't one of them
+func getDynamicGroupErrorRegion(ctx context.Context, d *plugin.QueryData) (string, error) {
+	homeRegion, err := getHomeRegion(ctx, d)
+	if err != nil {
+		return "", err
+	}
+
+	var regions []string
+	for _, item := range d.Matrix {
+		if region, ok := item[matrixKeyRegion].(string); ok {
+			if region == homeRegion {
+				return homeRegion, nil
+			}
+			regions = append(regions, region)
+		}
+	}
+	if len(regions) == 0 {
+		return homeRegion, nil
+	}
+	sort.Strings(regions)
+
+	return regions[0], nil
+}
+
+// dynamicGroupRuleEvaluationError describes why the matching rule of a dynamic
+// group cannot be fully evaluated, or returns an empty string if it can
+func dynamicGroupRuleEvaluationError(rule dynamicGroupRule) string {
+	if rule.ParseError != nil {
+		return rule.ParseError.Error()
+	}
+	if unsupported := rule.Rule.unsupported(); len(unsupported) > 0 {
+		return "not evaluated: " + strings.Join(unsupported, ", ")
+	}
+	return ""
+}
+
+// listDynamicGroupRules lists the active dynamic groups of the tenancy and
+// parses their matching rules. Groups whose rule cannot be parsed are returned
+// with the parse error.
+func listDynamicGroupRules(ctx context.Context, d *plugin.QueryData) ([]dynamicGroupRule, error) {
+	cacheKey := "listDynamicGroupRules"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.([]dynamicGroupRule), nil
+	}
+
+	// Create Session
+	session, err := identityService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []dynamicGroupRule
+	request := identity.ListDynamicGroupsRequest{
+		CompartmentId:  &session.TenancyID,
+		LifecycleState: identity.DynamicGroupLifecycleStateActive,
+		Limit:          types.Int(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+	for {
+		response, err := session.IdentityClient.ListDynamicGroups(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		for _, group := range response.Items {
+			rule, err := parseMatchingRule(types.SafeString(group.MatchingRule))
+			rules = append(rules, dynamicGroupRule{Group: group, Rule: rule, ParseError: err})
+		}
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+
+	d.ConnectionManager.Cache.Set(cacheKey, rules)
+
+	return rules, nil
+}
@@ -0,0 +1,640 @@
+package oci
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/identity"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableIdentityEffectivePermission(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_identity_effective_permission",
+		Description:      "OCI Identity Effective Permission",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			Hydrate: listIdentityEffectivePermissions,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "user_id",
+					Require: plugin.AnyOf,
+				},
+				{
+					Name:    "dynamic_group_id",
+					Require: plugin.AnyOf,
+				},
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "verb",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "resource_type",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "principal_type",
+				Description: "The type of principal the permission is resolved for: user or dynamic-group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "principal_name",
+				Description: "The name of the user or dynamic group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "user_id",
+				Description: "The OCID of the user the permission is resolved for.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "dynamic_group_id",
+				Description: "The OCID of the dynamic group the permission is resolved for.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "verb",
+				Description: "The access verb granted: inspect, read, use or manage. Verbs are cumulative, so a grant of manage also produces rows for use, read and inspect.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_implied",
+				Description: "True if no statement grants the verb directly, and it is only implied by a higher verb, e.g. read implied by a grant of manage.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "resource_type",
+				Description: "The individual resource type the verb is granted on. Aggregate types such as all-resources and virtual-network-family are expanded.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "permission",
+				Description: "The individual permission granted, for statements that list permissions instead of a verb and resource type. Deny statements of a verb are not applied to these rows.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "compartment_name",
+				Description: "The name of the compartment the permission applies in.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_inherited",
+				Description: "True if every granting statement applies in an ancestor compartment rather than this one.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "is_conditional",
+				Description: "True if every granting statement has a where clause, so the permission only applies when its conditions hold.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "granted_by",
+				Description: "The policy statements granting the permission.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "denied_by",
+				Description: "The conditional deny statements that may deny the permission. Permissions denied by a deny statement without conditions are not returned.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PrincipalName"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+type effectivePermissionGrant struct {
+	PolicyId              string  `json:"policy_id"`
+	PolicyName            string  `json:"policy_name"`
+	PolicyCompartmentId   string  `json:"policy_compartment_id"`
+	Statement             string  `json:"statement"`
+	Subject               string  `json:"subject"`
+	Resource              string  `json:"resource,omitempty"`
+	LocationCompartmentId string  `json:"location_compartment_id"`
+	Conditions            *string `json:"conditions,omitempty"`
+}
+
+type effectivePermissionInfo struct {
+	PrincipalType   string
+	PrincipalName   *string
+	UserId          *string
+	DynamicGroupId  *string
+	Verb            *string
+	IsImplied       bool
+	ResourceType    *string
+	Permission      *string
+	CompartmentId   string
+	CompartmentName *string
+	IsInherited     bool
+	IsConditional   bool
+	GrantedBy       []effectivePermissionGrant
+	DeniedBy        []effectivePermissionGrant
+}
+
+// effectivePrincipal is a user or dynamic group along with the names and
+// OCIDs a policy statement may use to refer to it
+type effectivePrincipal struct {
+	Type           string
+	Name           *string
+	UserId         *string
+	DynamicGroupId *string
+	Groups         map[string]string
+}
+
+//// LIST FUNCTION
+
+func listIdentityEffectivePermissions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	equalQuals := d.KeyColumnQuals
+
+	// Create Session
+	session, err := identityService(ctx, d)
+	if err != nil {
+		logger.Error("oci_identity_effective_permission.listIdentityEffectivePermissions", "connection_error", err)
+		return nil, err
+	}
+
+	var principals []effectivePrincipal
+	if equalQuals["user_id"] != nil {
+		principal, err := getEffectiveUserPrincipal(ctx, d, session, equalQuals["user_id"].GetStringValue())
+		if err != nil {
+			logger.Error("oci_identity_effective_permission.listIdentityEffectivePermissions", "api_error", err)
+			return nil, err
+		}
+		principals = append(principals, principal)
+	}
+	if equalQuals["dynamic_group_id"] != nil {
+		principal, err := getEffectiveDynamicGroupPrincipal(ctx, d, session, equalQuals["dynamic_group_id"].GetStringValue())
+		if err != nil {
+			logger.Error("oci_identity_effective_permission.listIdentityEffectivePermissions", "api_error", err)
+			return nil, err
+		}
+		principals = append(principals, principal)
+	}
+
+	tree, err := getCompartmentTree(ctx, d)
+	if err != nil {
+		logger.Error("oci_identity_effective_permission.listIdentityEffectivePermissions", "api_error", err)
+		return nil, err
+	}
+
+	policies, err := listAllIdentityPolicies(ctx, d, session, tree)
+	if err != nil {
+		logger.Error("oci_identity_effective_permission.listIdentityEffectivePermissions", "api_error", err)
+		return nil, err
+	}
+
+	for _, principal := range principals {
+		for _, item := range resolveEffectivePermissions(ctx, principal, policies, tree) {
+			// Skip, if given filters don't match
+			if equalQuals["compartment_id"] != nil && equalQuals["compartment_id"].GetStringValue() != item.CompartmentId {
+				continue
+			}
+			if equalQuals["verb"] != nil && equalQuals["verb"].GetStringValue() != types.SafeString(item.Verb) {
+				continue
+			}
+			if equalQuals["resource_type"] != nil && equalQuals["resource_type"].GetStringValue() != types.SafeString(item.ResourceType) {
+				continue
+			}
+
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func getEffectiveUserPrincipal(ctx context.Context, d *plugin.QueryData, session *session, userId string) (effectivePrincipal, error) {
+	user, err := session.IdentityClient.GetUser(ctx, identity.GetUserRequest{
+		UserId: types.String(userId),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	})
+	if err != nil {
+		return effectivePrincipal{}, err
+	}
+
+	principal := effectivePrincipal{
+		Type:   "user",
+		Name:   user.Name,
+		UserId: user.Id,
+		Groups: map[string]string{},
+	}
+
+	// Resolve the names of the groups the user belongs to
+	groupNames := map[string]string{}
+	groupsRequest := identity.ListGroupsRequest{
+		CompartmentId: &session.TenancyID,
+		Limit:         types.Int(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+	for {
+		response, err := session.IdentityClient.ListGroups(ctx, groupsRequest)
+		if err != nil {
+			return principal, err
+		}
+		for _, group := range response.Items {
+			groupNames[types.SafeString(group.Id)] = types.SafeString(group.Name)
+		}
+		if response.OpcNextPage == nil {
+			break
+		}
+		groupsRequest.Page = response.OpcNextPage
+	}
+
+	membershipsRequest := identity.ListUserGroupMembershipsRequest{
+		CompartmentId: &session.TenancyID,
+		UserId:        user.Id,
+		Limit:         types.Int(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+	for {
+		response, err := session.IdentityClient.ListUserGroupMemberships(ctx, membershipsRequest)
+		if err != nil {
+			return principal, err
+		}
+		for _, membership := range response.Items {
+			if membership.LifecycleState != identity.UserGroupMembershipLifecycleStateActive {
+				continue
+			}
+			groupId := types.SafeString(membership.GroupId)
+			principal.Groups[groupId] = groupNames[groupId]
+		}
+		if response.OpcNextPage == nil {
+			break
+		}
+		membershipsRequest.Page = response.OpcNextPage
+	}
+
+	return principal, nil
+}
+
+func getEffectiveDynamicGroupPrincipal(ctx context.Context, d *plugin.QueryData, session *session, dynamicGroupId string) (effectivePrincipal, error) {
+	response, err := session.IdentityClient.GetDynamicGroup(ctx, identity.GetDynamicGroupRequest{
+		DynamicGroupId: types.String(dynamicGroupId),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	})
+	if err != nil {
+		return effectivePrincipal{}, err
+	}
+
+	return effectivePrincipal{
+		Type:           "dynamic-group",
+		Name:           response.Name,
+		DynamicGroupId: response.Id,
+		Groups: map[string]string{
+			types.SafeString(response.Id): types.SafeString(response.Name),
+		},
+	}, nil
+}
+
+// listAllIdentityPolicies lists the active policies of every compartment in the tenancy
+func listAllIdentityPolicies(ctx context.Context, d *plugin.QueryData, session *session, tree *compartmentTree) ([]identity.Policy, error) {
+	var policies []identity.Policy
+
+	for _, compartmentId := range tree.subtree(tree.RootId) {
+		request := identity.ListPoliciesRequest{
+			CompartmentId:  types.String(compartmentId),
+			LifecycleState: identity.PolicyLifecycleStateActive,
+			Limit:          types.Int(1000),
+			RequestMetadata: common.RequestMetadata{
+				RetryPolicy: getDefaultRetryPolicy(d.Connection),
+			},
+		}
+		for {
+			response, err := session.IdentityClient.ListPolicies(ctx, request)
+			if err != nil {
+				return nil, err
+			}
+			policies = append(policies, response.Items...)
+			if response.OpcNextPage == nil {
+				break
+			}
+			request.Page = response.OpcNextPage
+		}
+	}
+
+	return policies, nil
+}
+
+// resolveEffectivePermissions evaluates the allow and deny statements of
+// every policy for the principal. Each statement is applied to its location
+// compartment and all of its descendants, aggregate resource types are
+// expanded and a verb also grants the lower verbs it includes. Conditions are
+// not evaluated; rows granted only by conditional statements are flagged
+// instead, and conditional deny statements are listed on the rows they may
+// deny.
+func resolveEffectivePermissions(ctx context.Context, principal effectivePrincipal, policies []identity.Policy, tree *compartmentTree) []effectivePermissionInfo {
+	rows := map[string]*effectivePermissionInfo{}
+	denials := map[string][]effectivePermissionGrant{}
+
+	for _, policy := range policies {
+		for _, statement := range policy.Statements {
+			parsed, err := parsePolicyStatement(statement)
+			if err != nil {
+				// The statement may be an allow or deny statement of the principal
+				plugin.Logger(ctx).Warn("oci_identity_effective_permission.resolveEffectivePermissions", "parse_error", err, "policy_id", types.SafeString(policy.Id), "statement", statement)
+				continue
+			}
+			if parsed.Action != "allow" && parsed.Action != "deny" {
+				continue
+			}
+
+			subject, ok := principal.matches(parsed)
+			if !ok {
+				continue
+			}
+
+			locationId, ok := resolvePolicyLocation(tree, types.SafeString(policy.CompartmentId), parsed)
+			if !ok {
+				plugin.Logger(ctx).Warn("oci_identity_effective_permission.resolveEffectivePermissions", "unresolved_location", statement, "policy_id", types.SafeString(policy.Id))
+				continue
+			}
+
+			grant := effectivePermissionGrant{
+				PolicyId:              types.SafeString(policy.Id),
+				PolicyName:            types.SafeString(policy.Name),
+				PolicyCompartmentId:   types.SafeString(policy.CompartmentId),
+				Statement:             statement,
+				Subject:               subject,
+				Resource:              parsed.Resource,
+				LocationCompartmentId: locationId,
+			}
+			if parsed.Conditions != "" {
+				grant.Conditions = types.String(parsed.Conditions)
+			}
+
+			// A statement grants either a verb on a set of resource types, or a list of permissions
+			var resourceTypes []string
+			if parsed.Verb != "" {
+				resourceTypes = expandPolicyResourceType(parsed.Resource)
+			}
+
+			for _, compartmentId := range tree.subtree(locationId) {
+				// Denying any verb on a resource type denies at least part of
+				// every verb on it, so a deny applies to all verbs
+				if parsed.Action == "deny" {
+					for _, resourceType := range resourceTypes {
+						key := effectivePermissionDenialKey(compartmentId, resourceType, "")
+						denials[key] = append(denials[key], grant)
+					}
+					for _, permission := range parsed.Permissions {
+						key := effectivePermissionDenialKey(compartmentId, "", permission)
+						denials[key] = append(denials[key], grant)
+					}
+					continue
+				}
+
+				for _, resourceType := range resourceTypes {
+					for _, verb := range impliedPolicyVerbs(parsed.Verb) {
+						addEffectivePermission(rows, principal, tree, compartmentId, types.String(verb), types.String(resourceType), nil, verb != parsed.Verb, grant)
+					}
+				}
+				for _, permission := range parsed.Permissions {
+					addEffectivePermission(rows, principal, tree, compartmentId, nil, nil, types.String(permission), false, grant)
+				}
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(rows))
+	for key := range rows {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	items := make([]effectivePermissionInfo, 0, len(keys))
+	for _, key := range keys {
+		row := rows[key]
+
+		deniedBy := denials[effectivePermissionDenialKey(row.CompartmentId, types.SafeString(row.ResourceType), types.SafeString(row.Permission))]
+		if isEffectivePermissionDenied(deniedBy) {
+			continue
+		}
+		row.DeniedBy = deniedBy
+
+		items = append(items, *row)
+	}
+	return items
+}
+
+func addEffectivePermission(rows map[string]*effectivePermissionInfo, principal effectivePrincipal, tree *compartmentTree, compartmentId string, verb *string, resourceType *string, permission *string, implied bool, grant effectivePermissionGrant) {
+	key := strings.Join([]string{compartmentId, types.SafeString(verb), types.SafeString(resourceType), types.SafeString(permission)}, "|")
+
+	row, ok := rows[key]
+	if !ok {
+		row = &effectivePermissionInfo{
+			PrincipalType:  principal.Type,
+			PrincipalName:  principal.Name,
+			UserId:         principal.UserId,
+			DynamicGroupId: principal.DynamicGroupId,
+			Verb:           verb,
+			IsImplied:      true,
+			ResourceType:   resourceType,
+			Permission:     permission,
+			CompartmentId:  compartmentId,
+			IsInherited:    true,
+			IsConditional:  true,
+		}
+		if name := tree.Nodes[compartmentId].Name; name != "" {
+			row.CompartmentName = types.String(name)
+		}
+		rows[key] = row
+	}
+
+	if !implied {
+		row.IsImplied = false
+	}
+	if grant.LocationCompartmentId == compartmentId {
+		row.IsInherited = false
+	}
+	if grant.Conditions == nil {
+		row.IsConditional = false
+	}
+	row.GrantedBy = append(row.GrantedBy, grant)
+}
+
+// effectivePermissionDenialKey identifies the resource type or permission a
+// deny statement applies to in a compartment
+func effectivePermissionDenialKey(compartmentId string, resourceType string, permission string) string {
+	return strings.Join([]string{compartmentId, resourceType, strings.ToUpper(permission)}, "|")
+}
+
+// isEffectivePermissionDenied reports whether any of the deny statements
+// applies without conditions
+func isEffectivePermissionDenied(deniedBy []effectivePermissionGrant) bool {
+	for _, grant := range deniedBy {
+		if grant.Conditions == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// policyVerbs lists the verbs of the policy language, each including the
+// access of the verbs before it
+var policyVerbs = []string{"inspect", "read", "use", "manage"}
+
+// impliedPolicyVerbs returns the verb along with the lower verbs it includes
+func impliedPolicyVerbs(verb string) []string {
+	for i, v := range policyVerbs {
+		if v == verb {
+			return policyVerbs[:i+1]
+		}
+	}
+	return []string{verb}
+}
+
+// matches reports whether the subject of a policy statement includes the
+// principal, and returns the subject that matched
+func (p effectivePrincipal) matches(statement *policyStatement) (string, bool) {
+	switch statement.SubjectType {
+	case "any-user":
+		return "any-user", true
+	case "any-group":
+		return "any-group", len(p.Groups) > 0
+	case "group":
+		if p.Type != "user" {
+			return "", false
+		}
+	case "dynamic-group":
+		if p.Type != "dynamic-group" {
+			return "", false
+		}
+	default:
+		return "", false
+	}
+
+	for _, subject := range statement.Subjects {
+		for id, name := range p.Groups {
+			// Groups outside of identity domains are referenced from the Default domain
+			if subject == id || strings.EqualFold(subject, name) || strings.EqualFold(subject, "Default/"+name) {
+				return statement.SubjectType + " " + subject, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// resolvePolicyLocation returns the OCID of the compartment a statement
+// applies in. Compartment paths are relative to the policy's compartment.
+func resolvePolicyLocation(tree *compartmentTree, policyCompartmentId string, statement *policyStatement) (string, bool) {
+	switch statement.LocationType {
+	case "tenancy":
+		return tree.RootId, statement.Location == ""
+	case "compartment":
+		if strings.HasPrefix(statement.Location, "ocid1.") {
+			_, ok := tree.Nodes[statement.Location]
+			return statement.Location, ok
+		}
+		return tree.resolvePath(policyCompartmentId, statement.Location)
+	}
+	return "", false
+}
+
+// policyResourceFamilies lists the individual resource types covered by each
+// aggregate resource type of the policy language
+var policyResourceFamilies = map[string][]string{
+	"autonomous-database-family": {"autonomous-backups", "autonomous-container-databases", "autonomous-databases", "autonomous-exadata-infrastructures", "cloud-autonomous-vmclusters"},
+	"cluster-family":             {"cluster-node-pools", "cluster-work-requests", "clusters"},
+	"compute-management-family":  {"cluster-networks", "instance-configurations", "instance-pools"},
+	"database-family":            {"backups", "cloud-exadata-infrastructures", "cloud-vmclusters", "databases", "db-homes", "db-nodes", "db-systems", "pluggable-databases"},
+	"dns":                        {"dns-records", "dns-resolvers", "dns-steering-policies", "dns-steering-policy-attachments", "dns-traffic", "dns-tsig-keys", "dns-views", "dns-zones"},
+	"email-family":               {"email-domains", "email-senders", "suppressions"},
+	"file-family":                {"export-sets", "file-systems", "mount-targets"},
+	"functions-family":           {"fn-app", "fn-function", "fn-invocation"},
+	"instance-family":            {"app-catalog-listing", "console-histories", "instance-console-connection", "instance-images", "instances", "volume-attachments"},
+	"logging-family":             {"log-content", "log-groups"},
+	"object-family":              {"buckets", "objects", "objectstorage-namespaces"},
+	"ons-family":                 {"ons-subscriptions", "ons-topics"},
+	"secret-family":              {"secret-bundles", "secrets"},
+	"stream-family":              {"connect-harness", "stream-pools", "stream-pull", "stream-push", "streams"},
+	"virtual-network-family":     {"byoip-ranges", "cpes", "cross-connect-groups", "cross-connects", "dhcp-options", "drg-attachments", "drg-route-distributions", "drg-route-tables", "drgs", "internet-gateways", "ipsec-connections", "ipv6s", "local-peering-gateways", "nat-gateways", "network-security-groups", "private-ips", "public-ip-pools", "public-ips", "remote-peering-connections", "route-tables", "security-lists", "service-gateways", "subnets", "vcns", "virtual-circuits", "vlans", "vnic-attachments", "vnics"},
+	"volume-family":              {"backup-policies", "boot-volume-backups", "volume-attachments", "volume-backups", "volume-group-backups", "volume-groups", "volumes"},
+}
+
+// policyIndividualResourceTypes lists resource types that belong to no family
+// but are covered by all-resources
+var policyIndividualResourceTypes = []string{
+	"alarms", "audit-events", "compartments", "dynamic-groups", "groups",
+	"identity-providers", "key-delegate", "keys", "load-balancers", "metrics", "network-load-balancers", "network-sources",
+	"policies", "repos", "tag-defaults", "tag-namespaces", "tenancies", "users", "vaults", "work-requests",
+}
+
+// expandPolicyResourceType returns the individual resource types covered by
+// a resource type named in a policy statement
+func expandPolicyResourceType(resource string) []string {
+	if members, ok := policyResourceFamilies[resource]; ok {
+		return members
+	}
+	if resource != "all-resources" {
+		return []string{resource}
+	}
+
+	seen := map[string]bool{}
+	var all []string
+	for _, members := range policyResourceFamilies {
+		for _, member := range members {
+			if !seen[member] {
+				seen[member] = true
+				all = append(all, member)
+			}
+		}
+	}
+	for _, resourceType := range policyIndividualResourceTypes {
+		if !seen[resourceType] {
+			seen[resourceType] = true
+			all = append(all, resourceType)
+		}
+	}
+	sort.Strings(all)
+
+	return all
+}
@@ -0,0 +1,244 @@
+package oci
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/oracle/oci-go-sdk/v65/identity"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/context_key"
+)
+
+// newTestCompartmentTree builds the hierarchy
+//
+//	tenancy
+//	├── Prod
+//	│   └── Network
+//	└── Dev
+func newTestCompartmentTree() *compartmentTree {
+	return &compartmentTree{
+		RootId: "tenancy",
+		Nodes: map[string]*compartmentNode{
+			"tenancy": {Id: "tenancy", Name: "acme", Children: []string{"dev", "prod"}},
+			"prod":    {Id: "prod", Name: "Prod", ParentId: "tenancy", Children: []string{"network"}},
+			"network": {Id: "network", Name: "Network", ParentId: "prod"},
+			"dev":     {Id: "dev", Name: "Dev", ParentId: "tenancy"},
+		},
+	}
+}
+
+func testPolicy(compartmentId string, statements ...string) identity.Policy {
+	return identity.Policy{
+		Id:            types.String("ocid1.policy.oc1..test"),
+		Name:          types.String("test"),
+		CompartmentId: types.String(compartmentId),
+		Statements:    statements,
+	}
+}
+
+// effectivePermissionRowKey summarizes a row as
+// compartment/verb/resource_type or compartment/permission
+func effectivePermissionRowKey(row effectivePermissionInfo) string {
+	if row.Permission != nil {
+		return row.CompartmentId + "/" + *row.Permission
+	}
+	return row.CompartmentId + "/" + types.SafeString(row.Verb) + "/" + types.SafeString(row.ResourceType)
+}
+
+func TestResolveEffectivePermissions(t *testing.T) {
+	ctx := context.WithValue(context.Background(), context_key.Logger, hclog.NewNullLogger())
+	tree := newTestCompartmentTree()
+
+	user := effectivePrincipal{
+		Type:   "user",
+		Name:   types.String("alice"),
+		UserId: types.String("ocid1.user.oc1..alice"),
+		Groups: map[string]string{"ocid1.group.oc1..ops": "Ops"},
+	}
+	dynamicGroup := effectivePrincipal{
+		Type:           "dynamic-group",
+		Name:           types.String("Workers"),
+		DynamicGroupId: types.String("ocid1.dynamicgroup.oc1..workers"),
+		Groups:         map[string]string{"ocid1.dynamicgroup.oc1..workers": "Workers"},
+	}
+
+	tests := []struct {
+		name      string
+		principal effectivePrincipal
+		policies  []identity.Policy
+		// rows filtered by the prefix, each summarized by effectivePermissionRowKey
+		prefix   string
+		expected []string
+		check    func(t *testing.T, rows map[string]effectivePermissionInfo)
+	}{
+		{
+			name:      "inherited by descendant compartments",
+			principal: user,
+			policies:  []identity.Policy{testPolicy("tenancy", "allow group Ops to inspect instances in compartment Prod")},
+			expected:  []string{"network/inspect/instances", "prod/inspect/instances"},
+			check: func(t *testing.T, rows map[string]effectivePermissionInfo) {
+				if rows["prod/inspect/instances"].IsInherited || !rows["network/inspect/instances"].IsInherited {
+					t.Errorf("unexpected is_inherited %+v", rows)
+				}
+				if types.SafeString(rows["network/inspect/instances"].CompartmentName) != "Network" {
+					t.Errorf("unexpected compartment name %v", rows["network/inspect/instances"].CompartmentName)
+				}
+			},
+		},
+		{
+			name:      "compartment path relative to the policy compartment",
+			principal: user,
+			policies:  []identity.Policy{testPolicy("prod", "allow group 'Default'/'Ops' to inspect vcns in compartment Network")},
+			expected:  []string{"network/inspect/vcns"},
+		},
+		{
+			name:      "compartment path from the tenancy",
+			principal: user,
+			policies:  []identity.Policy{testPolicy("tenancy", "allow group ocid1.group.oc1..ops to inspect vcns in compartment Prod:Network")},
+			expected:  []string{"network/inspect/vcns"},
+		},
+		{
+			name:      "unresolved compartment is skipped",
+			principal: user,
+			policies:  []identity.Policy{testPolicy("tenancy", "allow group Ops to inspect vcns in compartment Staging")},
+		},
+		{
+			name:      "implied verbs",
+			principal: user,
+			policies: []identity.Policy{testPolicy("tenancy",
+				"allow group Ops to manage buckets in compartment Dev",
+				"allow group Ops to read buckets in compartment Dev",
+			)},
+			expected: []string{"dev/inspect/buckets", "dev/manage/buckets", "dev/read/buckets", "dev/use/buckets"},
+			check: func(t *testing.T, rows map[string]effectivePermissionInfo) {
+				if rows["dev/manage/buckets"].IsImplied || !rows["dev/use/buckets"].IsImplied || rows["dev/read/buckets"].IsImplied || !rows["dev/inspect/buckets"].IsImplied {
+					t.Errorf("unexpected is_implied %+v", rows)
+				}
+				if len(rows["dev/read/buckets"].GrantedBy) != 2 {
+					t.Errorf("expected read to be granted by both statements, got %+v", rows["dev/read/buckets"].GrantedBy)
+				}
+			},
+		},
+		{
+			name:      "aggregate resource family",
+			principal: user,
+			policies:  []identity.Policy{testPolicy("tenancy", "allow group Ops to inspect object-family in compartment Dev")},
+			expected:  []string{"dev/inspect/buckets", "dev/inspect/objects", "dev/inspect/objectstorage-namespaces"},
+		},
+		{
+			name:      "all-resources",
+			principal: user,
+			policies:  []identity.Policy{testPolicy("tenancy", "allow group Ops to inspect all-resources in compartment Dev")},
+			prefix:    "dev/inspect/v",
+			expected:  []string{"dev/inspect/vaults", "dev/inspect/vcns", "dev/inspect/virtual-circuits", "dev/inspect/vlans", "dev/inspect/vnic-attachments", "dev/inspect/vnics", "dev/inspect/volume-attachments", "dev/inspect/volume-backups", "dev/inspect/volume-group-backups", "dev/inspect/volume-groups", "dev/inspect/volumes"},
+		},
+		{
+			name:      "where conditions",
+			principal: user,
+			policies: []identity.Policy{testPolicy("tenancy",
+				"allow group Ops to read secrets in compartment Dev where target.secret.name = 'db'",
+				"allow group Ops to inspect secrets in compartment Dev",
+			)},
+			expected: []string{"dev/inspect/secrets", "dev/read/secrets"},
+			check: func(t *testing.T, rows map[string]effectivePermissionInfo) {
+				read := rows["dev/read/secrets"]
+				if !read.IsConditional || types.SafeString(read.GrantedBy[0].Conditions) != "target.secret.name = 'db'" {
+					t.Errorf("expected conditional read, got %+v", read)
+				}
+				if rows["dev/inspect/secrets"].IsConditional {
+					t.Errorf("expected unconditional inspect, got %+v", rows["dev/inspect/secrets"])
+				}
+			},
+		},
+		{
+			name:      "permission list",
+			principal: user,
+			policies:  []identity.Policy{testPolicy("tenancy", "allow group Ops to {INSTANCE_READ, INSTANCE_POWER_ACTIONS} in compartment Dev")},
+			expected:  []string{"dev/INSTANCE_POWER_ACTIONS", "dev/INSTANCE_READ"},
+		},
+		{
+			name:      "deny without conditions",
+			principal: user,
+			policies: []identity.Policy{testPolicy("tenancy",
+				"allow group Ops to manage buckets in tenancy",
+				"deny group Ops to read buckets in compartment Prod",
+				"allow group Ops to {INSTANCE_READ, INSTANCE_DELETE} in compartment Dev",
+				"deny group Ops to {instance_delete} in compartment Dev",
+			)},
+			expected: []string{"dev/INSTANCE_READ", "dev/inspect/buckets", "dev/manage/buckets", "dev/read/buckets", "dev/use/buckets", "tenancy/inspect/buckets", "tenancy/manage/buckets", "tenancy/read/buckets", "tenancy/use/buckets"},
+		},
+		{
+			// documented limitation: the permissions each verb includes are not known
+			name:      "verb deny is not applied to permission list",
+			principal: user,
+			policies: []identity.Policy{testPolicy("tenancy",
+				"allow group Ops to {INSTANCE_READ} in compartment Dev",
+				"deny group Ops to manage instances in compartment Dev",
+			)},
+			expected: []string{"dev/INSTANCE_READ"},
+		},
+		{
+			name:      "deny with conditions",
+			principal: user,
+			policies: []identity.Policy{testPolicy("tenancy",
+				"allow group Ops to read buckets in compartment Dev",
+				"deny group Ops to manage buckets in compartment Dev where request.permission = 'BUCKET_DELETE'",
+			)},
+			expected: []string{"dev/inspect/buckets", "dev/read/buckets"},
+			check: func(t *testing.T, rows map[string]effectivePermissionInfo) {
+				if len(rows["dev/read/buckets"].DeniedBy) != 1 {
+					t.Errorf("expected a conditional denial, got %+v", rows["dev/read/buckets"].DeniedBy)
+				}
+			},
+		},
+		{
+			name:      "other subjects are ignored",
+			principal: user,
+			policies: []identity.Policy{testPolicy("tenancy",
+				"allow group Admins to manage buckets in tenancy",
+				"allow dynamic-group Ops to manage buckets in tenancy",
+				"deny group Admins to inspect instances in tenancy",
+				"allow any-user to inspect instances in compartment Dev",
+				"endorse group Ops to manage buckets in any-tenancy",
+				"this is not a policy statement",
+			)},
+			expected: []string{"dev/inspect/instances"},
+		},
+		{
+			name:      "dynamic group",
+			principal: dynamicGroup,
+			policies: []identity.Policy{testPolicy("tenancy",
+				"allow dynamic-group Workers to use stream-push in compartment Dev",
+				"allow group Workers to manage buckets in tenancy",
+				"allow any-group to inspect instances in compartment Dev",
+			)},
+			expected: []string{"dev/inspect/instances", "dev/inspect/stream-push", "dev/read/stream-push", "dev/use/stream-push"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rows := map[string]effectivePermissionInfo{}
+			var keys []string
+			for _, row := range resolveEffectivePermissions(ctx, test.principal, test.policies, tree) {
+				key := effectivePermissionRowKey(row)
+				if !strings.HasPrefix(key, test.prefix) {
+					continue
+				}
+				rows[key] = row
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+
+			if strings.Join(keys, ",") != strings.Join(test.expected, ",") {
+				t.Fatalf("unexpected rows\n got %v\nwant %v", keys, test.expected)
+			}
+			if test.check != nil {
+				test.check(t, rows)
+			}
+		})
+	}
+}
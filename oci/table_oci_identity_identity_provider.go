@@ -0,0 +1,307 @@
+package oci
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/pem"
+	"strings"
+
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/identity"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableIdentityIdentityProvider(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_identity_identity_provider",
+		Description:      "OCI Identity Identity Provider",
+		DefaultTransform: transform.FromCamel(),
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getIdentityProvider,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listIdentityProviders,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "lifecycle_state",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: CertificateColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name you assign to the identity provider during creation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The OCID of the identity provider.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "product_type",
+				Description: "The identity provider service or product, e.g. IDCS or ADFS.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "protocol",
+				Description: "The protocol used for federation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("SAML2"),
+			},
+			{
+				Name:        "lifecycle_state",
+				Description: "The current state of the identity provider.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_created",
+				Description: "Date and time the identity provider was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeCreated.Time"),
+			},
+			{
+				Name:        "description",
+				Description: "The description you assign to the identity provider during creation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "inactive_status",
+				Description: "The detailed status of INACTIVE lifecycleState.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "metadata_url",
+				Description: "The URL for retrieving the identity provider's metadata.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "redirect_url",
+				Description: "The URL to redirect federated users to for authentication with the identity provider.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "signing_certificate",
+				Description: "The identity provider's signing certificate used by the IAM Service to validate the SAML2 token.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "freeform_attributes",
+				Description: "Extra name value pairs associated with this identity provider.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// tags
+			{
+				Name:        "defined_tags",
+				Description: ColumnDescriptionDefinedTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "freeform_tags",
+				Description: ColumnDescriptionFreefromTags,
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(identityProviderTags),
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+type identityProviderInfo struct {
+	identity.Saml2IdentityProvider
+	CertificateDetails    *certificateDetails
+	CertificateParseError *string
+}
+
+//// LIST FUNCTION
+
+func listIdentityProviders(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	equalQuals := d.KeyColumnQuals
+
+	// Create Session
+	session, err := identityService(ctx, d)
+	if err != nil {
+		logger.Error("oci_identity_identity_provider.listIdentityProviders", "connection_error", err)
+		return nil, err
+	}
+
+	request := identity.ListIdentityProvidersRequest{
+		CompartmentId: &session.TenancyID,
+		Protocol:      identity.ListIdentityProvidersProtocolSaml2,
+		Limit:         types.Int(1000),
+		RequestMetadata: oci_common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	// Check for additional filters
+	if equalQuals["name"] != nil {
+		request.Name = types.String(equalQuals["name"].GetStringValue())
+	}
+	if equalQuals["lifecycle_state"] != nil {
+		request.LifecycleState = identity.IdentityProviderLifecycleStateEnum(equalQuals["lifecycle_state"].GetStringValue())
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.IdentityClient.ListIdentityProviders(ctx, request)
+		if err != nil {
+			logger.Error("oci_identity_identity_provider.listIdentityProviders", "api_error", err)
+			return nil, err
+		}
+
+		for _, provider := range response.Items {
+			// SAML2 is the only federation protocol
+			saml2Provider, ok := provider.(identity.Saml2IdentityProvider)
+			if !ok {
+				continue
+			}
+			d.StreamListItem(ctx, buildIdentityProviderInfo(ctx, saml2Provider))
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTION
+
+func getIdentityProvider(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	id := d.KeyColumnQuals["id"].GetStringValue()
+
+	// handle empty id in get call
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := identityService(ctx, d)
+	if err != nil {
+		logger.Error("oci_identity_identity_provider.getIdentityProvider", "connection_error", err)
+		return nil, err
+	}
+
+	request := identity.GetIdentityProviderRequest{
+		IdentityProviderId: types.String(id),
+		RequestMetadata: oci_common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.IdentityClient.GetIdentityProvider(ctx, request)
+	if err != nil {
+		logger.Error("oci_identity_identity_provider.getIdentityProvider", "api_error", err)
+		return nil, err
+	}
+
+	saml2Provider, ok := response.IdentityProvider.(identity.Saml2IdentityProvider)
+	if !ok {
+		return nil, nil
+	}
+
+	return buildIdentityProviderInfo(ctx, saml2Provider), nil
+}
+
+// buildIdentityProviderInfo parses the signing certificate of the provider,
+// which is returned as base64 encoded DER without PEM armour
+func buildIdentityProviderInfo(ctx context.Context, provider identity.Saml2IdentityProvider) identityProviderInfo {
+	item := identityProviderInfo{Saml2IdentityProvider: provider}
+
+	certificate := strings.TrimSpace(types.SafeString(provider.SigningCertificate))
+	if certificate == "" {
+		return item
+	}
+	if !strings.Contains(certificate, "-----BEGIN") {
+		der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(certificate), ""))
+		if err == nil {
+			certificate = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+		}
+	}
+
+	details, err := parsePEMCertificate(certificate)
+	if err != nil {
+		plugin.Logger(ctx).Debug("oci_identity_identity_provider.buildIdentityProviderInfo", "parse_error", err, "id", types.SafeString(provider.Id))
+		item.CertificateParseError = types.String(err.Error())
+	} else {
+		item.CertificateDetails = details
+	}
+
+	return item
+}
+
+//// TRANSFORM FUNCTION
+
+func identityProviderTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	provider := d.HydrateItem.(identityProviderInfo)
+
+	var tags map[string]interface{}
+
+	if provider.FreeformTags != nil {
+		tags = map[string]interface{}{}
+		for k, v := range provider.FreeformTags {
+			tags[k] = v
+		}
+	}
+
+	if provider.DefinedTags != nil {
+		if tags == nil {
+			tags = map[string]interface{}{}
+		}
+		for _, v := range provider.DefinedTags {
+			for key, value := range v {
+				tags[key] = value
+			}
+		}
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,165 @@
+package oci
+
+import (
+	"context"
+
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/identity"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableIdentityIdpGroupMapping(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_identity_idp_group_mapping",
+		Description:      "OCI Identity IdP Group Mapping",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			ParentHydrate: listIdentityProviders,
+			Hydrate:       listIdentityIdpGroupMappings,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "identity_provider_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "group_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The OCID of the IdP group mapping.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "identity_provider_id",
+				Description: "The OCID of the identity provider.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IdpId"),
+			},
+			{
+				Name:        "identity_provider_name",
+				Description: "The name of the identity provider.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "idp_group_name",
+				Description: "The name of the identity provider group that is mapped to the IAM Service group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "group_id",
+				Description: "The OCID of the IAM Service group that is mapped to the identity provider group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_state",
+				Description: "The mapping's current state.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_created",
+				Description: "Date and time the mapping was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeCreated.Time"),
+			},
+			{
+				Name:        "inactive_status",
+				Description: "The detailed status of INACTIVE lifecycleState.",
+				Type:        proto.ColumnType_INT,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IdpGroupName"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+type idpGroupMappingInfo struct {
+	identity.IdpGroupMapping
+	IdentityProviderName *string
+}
+
+//// LIST FUNCTION
+
+func listIdentityIdpGroupMappings(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	provider := h.Item.(identityProviderInfo)
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given identity_provider_id doesn't match
+	if equalQuals["identity_provider_id"] != nil && equalQuals["identity_provider_id"].GetStringValue() != types.SafeString(provider.Id) {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := identityService(ctx, d)
+	if err != nil {
+		logger.Error("oci_identity_idp_group_mapping.listIdentityIdpGroupMappings", "connection_error", err)
+		return nil, err
+	}
+
+	request := identity.ListIdpGroupMappingsRequest{
+		IdentityProviderId: provider.Id,
+		Limit:              types.Int(1000),
+		RequestMetadata: oci_common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.IdentityClient.ListIdpGroupMappings(ctx, request)
+		if err != nil {
+			logger.Error("oci_identity_idp_group_mapping.listIdentityIdpGroupMappings", "api_error", err)
+			return nil, err
+		}
+
+		for _, mapping := range response.Items {
+			// Skip, if given group_id doesn't match
+			if equalQuals["group_id"] != nil && equalQuals["group_id"].GetStringValue() != types.SafeString(mapping.GroupId) {
+				continue
+			}
+
+			d.StreamLeafListItem(ctx, idpGroupMappingInfo{mapping, provider.Name})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
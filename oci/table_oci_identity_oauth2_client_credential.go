@@ -0,0 +1,159 @@
+package oci
+
+import (
+	"context"
+
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/identity"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableIdentityOAuth2ClientCredential(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_identity_oauth2_client_credential",
+		Description:      "OCI Identity OAuth 2.0 Client Credential",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			ParentHydrate: listUsers,
+			Hydrate:       listIdentityOAuth2ClientCredentials,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "user_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "Name of the OAuth 2.0 client credential.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The OCID of the OAuth 2.0 client credential.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "user_id",
+				Description: "The OCID of the user the OAuth 2.0 client credential belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "user_name",
+				Description: "The name of the user the OAuth 2.0 client credential belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_state",
+				Description: "The credential's current state.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_created",
+				Description: "Date and time the OAuth 2.0 client credential was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeCreated.Time"),
+			},
+			{
+				Name:        "expires_on",
+				Description: "Date and time when this credential will expire.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ExpiresOn.Time"),
+			},
+			{
+				Name:        "description",
+				Description: "Description of the OAuth 2.0 client credential.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "scopes",
+				Description: "The list of scopes the principal of the OAuth 2.0 client credential can access.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+type oAuth2ClientCredentialInfo struct {
+	identity.OAuth2ClientCredentialSummary
+	UserName string
+}
+
+//// LIST FUNCTION
+
+func listIdentityOAuth2ClientCredentials(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	user := h.Item.(identity.User)
+
+	// Return nil, if given user_id doesn't match
+	equalQuals := d.KeyColumnQuals
+	if equalQuals["user_id"] != nil && equalQuals["user_id"].GetStringValue() != *user.Id {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := identityService(ctx, d)
+	if err != nil {
+		logger.Error("oci_identity_oauth2_client_credential.listIdentityOAuth2ClientCredentials", "connection_error", err)
+		return nil, err
+	}
+
+	request := identity.ListOAuthClientCredentialsRequest{
+		UserId: user.Id,
+		RequestMetadata: oci_common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.IdentityClient.ListOAuthClientCredentials(ctx, request)
+		if err != nil {
+			logger.Error("oci_identity_oauth2_client_credential.listIdentityOAuth2ClientCredentials", "api_error", err)
+			return nil, err
+		}
+
+		for _, credential := range response.Items {
+			d.StreamLeafListItem(ctx, oAuth2ClientCredentialInfo{credential, *user.Name})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
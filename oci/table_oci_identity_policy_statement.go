@@ -0,0 +1,264 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/identity"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableIdentityPolicyStatement(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_identity_policy_statement",
+		Description:      "OCI Identity Policy Statement",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			Hydrate: listIdentityPolicyStatements,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "policy_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "policy_name",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartmentList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "policy_name",
+				Description: "The name of the policy containing the statement.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "policy_id",
+				Description: "The OCID of the policy containing the statement.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "statement_index",
+				Description: "The zero-based position of the statement within the policy.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "statement",
+				Description: "The policy statement as written in the policy language.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_parsed",
+				Description: "True if the statement was parsed successfully.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "parse_error",
+				Description: "The reason the statement could not be parsed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "action",
+				Description: "The kind of statement: allow, deny, endorse, admit or define.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Parsed.Action").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "subject_type",
+				Description: "The type of subject the statement applies to: group, dynamic-group, service, any-user or any-group. For define statements, the type of the alias.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Parsed.SubjectType").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "subjects",
+				Description: "The names or OCIDs of the subjects the statement applies to. Names of groups in an identity domain are qualified as domain/group.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Parsed.Subjects"),
+			},
+			{
+				Name:        "subject_tenancy",
+				Description: "The alias of the tenancy the subjects belong to, for admit statements.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Parsed.SubjectTenancy").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "verb",
+				Description: "The access verb granted: inspect, read, use or manage.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Parsed.Verb").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "permissions",
+				Description: "The individual permissions granted, when the statement lists permissions instead of a verb and resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Parsed.Permissions"),
+			},
+			{
+				Name:        "resource",
+				Description: "The resource type or family the access is granted on, e.g. instances or all-resources.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Parsed.Resource").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "location_type",
+				Description: "The type of location the statement applies in: tenancy, any-tenancy or compartment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Parsed.LocationType").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "location",
+				Description: "The compartment path or OCID the statement applies in, relative to the policy's compartment, or the tenancy alias for endorse statements.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Parsed.Location").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "conditions",
+				Description: "The conditions of the where clause, as written.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Parsed.Conditions").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "defined_as",
+				Description: "The OCID an alias refers to, for define statements.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Parsed.DefinedAs").Transform(transform.NullIfZeroValue),
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Statement"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+type policyStatementInfo struct {
+	PolicyId       *string
+	PolicyName     *string
+	CompartmentId  *string
+	StatementIndex int
+	Statement      string
+	IsParsed       bool
+	ParseError     *string
+	Parsed         policyStatement
+}
+
+//// LIST FUNCTION
+
+func listIdentityPolicyStatements(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given compartment_id doesn't match
+	if equalQuals["compartment_id"] != nil && compartment != equalQuals["compartment_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := identityService(ctx, d)
+	if err != nil {
+		logger.Error("oci_identity_policy_statement.listIdentityPolicyStatements", "connection_error", err)
+		return nil, err
+	}
+
+	request := identity.ListPoliciesRequest{
+		CompartmentId: types.String(compartment),
+		Limit:         types.Int(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	// Check for additional filter
+	if equalQuals["policy_name"] != nil {
+		request.Name = types.String(equalQuals["policy_name"].GetStringValue())
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.IdentityClient.ListPolicies(ctx, request)
+		if err != nil {
+			logger.Error("oci_identity_policy_statement.listIdentityPolicyStatements", "api_error", err)
+			return nil, err
+		}
+
+		for _, policy := range response.Items {
+			// Skip, if given policy_id doesn't match
+			if equalQuals["policy_id"] != nil && equalQuals["policy_id"].GetStringValue() != types.SafeString(policy.Id) {
+				continue
+			}
+
+			for _, item := range buildPolicyStatements(policy) {
+				d.StreamListItem(ctx, item)
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+// buildPolicyStatements parses each statement of the policy. Statements that
+// cannot be parsed are still returned, flagged with the parse error.
+func buildPolicyStatements(policy identity.Policy) []policyStatementInfo {
+	items := make([]policyStatementInfo, 0, len(policy.Statements))
+
+	for i, statement := range policy.Statements {
+		item := policyStatementInfo{
+			PolicyId:       policy.Id,
+			PolicyName:     policy.Name,
+			CompartmentId:  policy.CompartmentId,
+			StatementIndex: i,
+			Statement:      statement,
+		}
+
+		parsed, err := parsePolicyStatement(statement)
+		if err != nil {
+			item.ParseError = types.String(err.Error())
+		} else {
+			item.IsParsed = true
+			item.Parsed = *parsed
+		}
+
+		items = append(items, item)
+	}
+
+	return items
+}
@@ -0,0 +1,278 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/identity"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableIdentityTag(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_identity_tag",
+		Description:      "OCI Identity Tag",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			ParentHydrate: listIdentityTagNamespaces,
+			Hydrate:       listIdentityTags,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "tag_namespace_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartmentList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name assigned to the tag during creation. This is the tag key definition.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The OCID of the tag definition.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tag_namespace_id",
+				Description: "The OCID of the namespace that contains the tag definition.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tag_namespace_name",
+				Description: "The name of the tag namespace that contains the tag definition.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_retired",
+				Description: "Whether the tag is retired.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "is_cost_tracking",
+				Description: "Indicates whether the tag is enabled for cost tracking.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "lifecycle_state",
+				Description: "The tag's current state.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description assigned to the tag.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_created",
+				Description: "Date and time the tag was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeCreated.Time"),
+			},
+			{
+				Name:        "validator_type",
+				Description: "The type of validator applied to the tag values, either ENUM or DEFAULT. DEFAULT allows any value.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getIdentityTag,
+				Transform:   transform.FromField("Validator").Transform(identityTagValidatorType),
+			},
+			{
+				Name:        "validator_values",
+				Description: "The list of allowed values for the tag, if it has an ENUM validator.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getIdentityTag,
+				Transform:   transform.FromField("Validator").Transform(identityTagValidatorValues),
+			},
+
+			// Tags
+			{
+				Name:        "defined_tags",
+				Description: ColumnDescriptionDefinedTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "freeform_tags",
+				Description: ColumnDescriptionFreefromTags,
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(identityTagTitle),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(identityTagTags),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+type identityTagInfo struct {
+	identity.TagSummary
+	TagNamespaceId   *string
+	TagNamespaceName *string
+}
+
+//// LIST FUNCTION
+
+func listIdentityTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	tagNamespace := h.Item.(identity.TagNamespaceSummary)
+
+	// Return nil, if given tag_namespace_id doesn't match
+	if d.KeyColumnQuals["tag_namespace_id"] != nil && types.SafeString(tagNamespace.Id) != d.KeyColumnQuals["tag_namespace_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := identityService(ctx, d)
+	if err != nil {
+		logger.Error("oci_identity_tag.listIdentityTags", "connection_error", err)
+		return nil, err
+	}
+
+	request := identity.ListTagsRequest{
+		TagNamespaceId: tagNamespace.Id,
+		Limit:          types.Int(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.IdentityClient.ListTags(ctx, request)
+		if err != nil {
+			logger.Error("oci_identity_tag.listIdentityTags", "api_error", err)
+			return nil, err
+		}
+
+		for _, tag := range response.Items {
+			d.StreamLeafListItem(ctx, identityTagInfo{tag, tagNamespace.Id, tagNamespace.Name})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTION
+
+// getIdentityTag fetches the tag definition, as the validator is not included
+// in the list response
+func getIdentityTag(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	tag := h.Item.(identityTagInfo)
+
+	// Create Session
+	session, err := identityService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("oci_identity_tag.getIdentityTag", "connection_error", err)
+		return nil, err
+	}
+
+	request := identity.GetTagRequest{
+		TagNamespaceId: tag.TagNamespaceId,
+		TagName:        tag.Name,
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.IdentityClient.GetTag(ctx, request)
+	if err != nil {
+		plugin.Logger(ctx).Error("oci_identity_tag.getIdentityTag", "api_error", err)
+		return nil, err
+	}
+
+	return response.Tag, nil
+}
+
+//// TRANSFORM FUNCTION
+
+func identityTagValidatorType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	switch d.Value.(type) {
+	case identity.EnumTagDefinitionValidator:
+		return string(identity.BaseTagDefinitionValidatorValidatorTypeEnumvalue), nil
+	case identity.DefaultTagDefinitionValidator:
+		return string(identity.BaseTagDefinitionValidatorValidatorTypeDefault), nil
+	}
+	return nil, nil
+}
+
+func identityTagValidatorValues(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if validator, ok := d.Value.(identity.EnumTagDefinitionValidator); ok {
+		return validator.Values, nil
+	}
+	return nil, nil
+}
+
+func identityTagTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tag := d.HydrateItem.(identityTagInfo)
+	return types.SafeString(tag.TagNamespaceName) + "." + types.SafeString(tag.Name), nil
+}
+
+func identityTagTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tag := d.HydrateItem.(identityTagInfo)
+
+	var tags map[string]interface{}
+
+	if tag.FreeformTags != nil {
+		tags = map[string]interface{}{}
+		for k, v := range tag.FreeformTags {
+			tags[k] = v
+		}
+	}
+
+	if tag.DefinedTags != nil {
+		if tags == nil {
+			tags = map[string]interface{}{}
+		}
+		for _, v := range tag.DefinedTags {
+			for key, value := range v {
+				tags[key] = value
+			}
+		}
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,508 @@
+package oci
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/identity"
+	"github.com/oracle/oci-go-sdk/v65/resourcesearch"
+	"github.com/turbot/go-kit/helpers"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableIdentityTagCompliance(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_identity_tag_compliance",
+		Description:      "OCI Identity Tag Compliance",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			Hydrate: listIdentityTagCompliance,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "resource_type",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "status",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "resource_id",
+				Description: "The OCID of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_type",
+				Description: "The resource type name, as used by Resource Search, e.g. Instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_name",
+				Description: "The display name of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "check_type",
+				Description: "The governance check, either REQUIRED_TAG for a required tag default or ENUM_VALUE for a tag with an enum validator.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The result of the check, ok if the resource complies or alarm if it does not.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "reason",
+				Description: "A description of the result of the check.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tag_namespace_name",
+				Description: "The name of the tag namespace of the checked tag.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tag_name",
+				Description: "The name of the checked tag.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tag_value",
+				Description: "The value of the checked tag on the resource, if set.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "allowed_values",
+				Description: "The values allowed by the enum validator of the tag.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tag_default_id",
+				Description: "The OCID of the required tag default that was checked.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(identityTagComplianceTitle),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+const (
+	tagComplianceCheckRequiredTag = "REQUIRED_TAG"
+	tagComplianceCheckEnumValue   = "ENUM_VALUE"
+)
+
+type identityTagComplianceInfo struct {
+	ResourceId       *string
+	ResourceType     *string
+	ResourceName     *string
+	CheckType        string
+	Status           string
+	Reason           string
+	TagNamespaceName string
+	TagName          string
+	TagValue         *string
+	AllowedValues    []string
+	TagDefaultId     *string
+	Region           string
+	CompartmentId    *string
+}
+
+// identityTagGovernance holds the tenancy wide tagging rules resources are
+// checked against
+type identityTagGovernance struct {
+	// allowed values of tags with an enum validator, keyed by lower case
+	// namespace and tag name
+	EnumValues map[string][]string
+
+	// active required tag defaults, with the compartment they apply from
+	RequiredDefaults []identityRequiredTagDefault
+
+	// parent of each compartment, to resolve inherited tag defaults
+	CompartmentParents map[string]string
+}
+
+type identityRequiredTagDefault struct {
+	identity.TagDefaultSummary
+	TagNamespaceName string
+}
+
+//// LIST FUNCTION
+
+func listIdentityTagCompliance(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	equalQuals := d.KeyColumnQuals
+
+	governance, err := getIdentityTagGovernance(ctx, d)
+	if err != nil {
+		logger.Error("oci_identity_tag_compliance.listIdentityTagCompliance", "governance_error", err)
+		return nil, err
+	}
+
+	// Resources without a region in their OCID, e.g. IAM resources, are returned
+	// by every region, so only report them in the home region
+	homeRegion, err := getHomeRegion(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create Session
+	session, err := resourceSearchService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_identity_tag_compliance.listIdentityTagCompliance", "connection_error", err)
+		return nil, err
+	}
+
+	resourceType := "all"
+	if equalQuals["resource_type"] != nil {
+		resourceType = equalQuals["resource_type"].GetStringValue()
+	}
+	compartmentId := ""
+	if equalQuals["compartment_id"] != nil {
+		compartmentId = equalQuals["compartment_id"].GetStringValue()
+	}
+	query, ok := buildTagComplianceSearchQuery(resourceType, compartmentId)
+	if !ok {
+		// Values that cannot be a resource type or OCID match no resources
+		return nil, nil
+	}
+
+	request := resourcesearch.SearchResourcesRequest{
+		Limit: types.Int(1000),
+		SearchDetails: resourcesearch.StructuredSearchDetails{
+			Query: types.String(query),
+		},
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.ResourceSearchClient.SearchResources(ctx, request)
+		if err != nil {
+			logger.Error("oci_identity_tag_compliance.listIdentityTagCompliance", "api_error", err)
+			return nil, err
+		}
+
+		for _, resource := range response.Items {
+			if string(ociRegionNameFromId(types.SafeString(resource.Identifier))) == "" && region != homeRegion {
+				continue
+			}
+
+			for _, item := range checkIdentityTagCompliance(governance, resource) {
+				if equalQuals["status"] != nil && item.Status != equalQuals["status"].GetStringValue() {
+					continue
+				}
+				item.Region = region
+				d.StreamListItem(ctx, item)
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+// checkIdentityTagCompliance checks the defined tags of a resource against the
+// required tag defaults of its compartment and its ancestors, and against the
+// enum validators of the tags it has
+func checkIdentityTagCompliance(governance *identityTagGovernance, resource resourcesearch.ResourceSummary) []identityTagComplianceInfo {
+	var items []identityTagComplianceInfo
+
+	newItem := func(checkType string, namespace string, name string) identityTagComplianceInfo {
+		item := identityTagComplianceInfo{
+			ResourceId:       resource.Identifier,
+			ResourceType:     resource.ResourceType,
+			ResourceName:     resource.DisplayName,
+			CheckType:        checkType,
+			TagNamespaceName: namespace,
+			TagName:          name,
+			CompartmentId:    resource.CompartmentId,
+		}
+		if value, ok := lookupDefinedTag(resource.DefinedTags, namespace, name); ok {
+			item.TagValue = types.String(value)
+		}
+		return item
+	}
+
+	// Compartments the resource inherits tag defaults from
+	compartments := map[string]bool{}
+	for id := types.SafeString(resource.CompartmentId); id != "" && !compartments[id]; id = governance.CompartmentParents[id] {
+		compartments[id] = true
+	}
+
+	for _, tagDefault := range governance.RequiredDefaults {
+		if !compartments[types.SafeString(tagDefault.CompartmentId)] {
+			continue
+		}
+		item := newItem(tagComplianceCheckRequiredTag, tagDefault.TagNamespaceName, types.SafeString(tagDefault.TagDefinitionName))
+		item.TagDefaultId = tagDefault.Id
+		if item.TagValue == nil {
+			item.Status = "alarm"
+			item.Reason = fmt.Sprintf("%s.%s is required but not set.", item.TagNamespaceName, item.TagName)
+		} else {
+			item.Status = "ok"
+			item.Reason = fmt.Sprintf("%s.%s is set.", item.TagNamespaceName, item.TagName)
+		}
+		items = append(items, item)
+	}
+
+	for namespace, tags := range resource.DefinedTags {
+		for name := range tags {
+			allowed, ok := governance.EnumValues[identityTagKey(namespace, name)]
+			if !ok {
+				continue
+			}
+			item := newItem(tagComplianceCheckEnumValue, namespace, name)
+			item.AllowedValues = allowed
+			if helpers.StringSliceContains(allowed, types.SafeString(item.TagValue)) {
+				item.Status = "ok"
+				item.Reason = fmt.Sprintf("%s.%s has an allowed value.", namespace, name)
+			} else {
+				item.Status = "alarm"
+				item.Reason = fmt.Sprintf("%s.%s value %q is not one of the allowed values.", namespace, name, types.SafeString(item.TagValue))
+			}
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+// lookupDefinedTag finds a defined tag, matching namespace and tag names case
+// insensitively as OCI does
+func lookupDefinedTag(definedTags map[string]map[string]interface{}, namespace string, name string) (string, bool) {
+	for ns, tags := range definedTags {
+		if !strings.EqualFold(ns, namespace) {
+			continue
+		}
+		for key, value := range tags {
+			if strings.EqualFold(key, name) {
+				return types.ToString(value), true
+			}
+		}
+	}
+	return "", false
+}
+
+func identityTagKey(namespace string, name string) string {
+	return strings.ToLower(namespace) + "." + strings.ToLower(name)
+}
+
+// getIdentityTagGovernance loads the enum validators and required tag defaults
+// of the tenancy, cached for the connection
+func getIdentityTagGovernance(ctx context.Context, d *plugin.QueryData) (*identityTagGovernance, error) {
+	cacheKey := "getIdentityTagGovernance"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(*identityTagGovernance), nil
+	}
+
+	// Create Session
+	session, err := identityService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	compartments, err := listAllCompartments(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	governance := &identityTagGovernance{
+		EnumValues:         map[string][]string{},
+		CompartmentParents: map[string]string{},
+	}
+	namespaceNames := map[string]string{}
+
+	for _, compartment := range compartments {
+		if compartment.CompartmentId != nil {
+			governance.CompartmentParents[*compartment.Id] = *compartment.CompartmentId
+		}
+
+		namespaceRequest := identity.ListTagNamespacesRequest{
+			CompartmentId:  compartment.Id,
+			LifecycleState: identity.TagNamespaceLifecycleStateActive,
+			Limit:          types.Int(1000),
+			RequestMetadata: common.RequestMetadata{
+				RetryPolicy: getDefaultRetryPolicy(d.Connection),
+			},
+		}
+		for {
+			response, err := session.IdentityClient.ListTagNamespaces(ctx, namespaceRequest)
+			if err != nil {
+				return nil, err
+			}
+			for _, namespace := range response.Items {
+				namespaceNames[*namespace.Id] = *namespace.Name
+				if err := loadIdentityTagEnumValues(ctx, d, session, namespace, governance.EnumValues); err != nil {
+					return nil, err
+				}
+			}
+			if response.OpcNextPage == nil {
+				break
+			}
+			namespaceRequest.Page = response.OpcNextPage
+		}
+	}
+
+	for _, compartment := range compartments {
+		defaultRequest := identity.ListTagDefaultsRequest{
+			CompartmentId:  compartment.Id,
+			LifecycleState: identity.TagDefaultSummaryLifecycleStateActive,
+			Limit:          types.Int(1000),
+			RequestMetadata: common.RequestMetadata{
+				RetryPolicy: getDefaultRetryPolicy(d.Connection),
+			},
+		}
+		for {
+			response, err := session.IdentityClient.ListTagDefaults(ctx, defaultRequest)
+			if err != nil {
+				return nil, err
+			}
+			for _, tagDefault := range response.Items {
+				if tagDefault.IsRequired == nil || !*tagDefault.IsRequired {
+					continue
+				}
+				governance.RequiredDefaults = append(governance.RequiredDefaults, identityRequiredTagDefault{tagDefault, namespaceNames[types.SafeString(tagDefault.TagNamespaceId)]})
+			}
+			if response.OpcNextPage == nil {
+				break
+			}
+			defaultRequest.Page = response.OpcNextPage
+		}
+	}
+
+	d.ConnectionManager.Cache.Set(cacheKey, governance)
+
+	return governance, nil
+}
+
+// loadIdentityTagEnumValues adds the allowed values of the active tags with an
+// enum validator in the namespace
+func loadIdentityTagEnumValues(ctx context.Context, d *plugin.QueryData, session *session, namespace identity.TagNamespaceSummary, enumValues map[string][]string) error {
+	request := identity.ListTagsRequest{
+		TagNamespaceId: namespace.Id,
+		LifecycleState: identity.TagLifecycleStateActive,
+		Limit:          types.Int(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	for {
+		response, err := session.IdentityClient.ListTags(ctx, request)
+		if err != nil {
+			return err
+		}
+		for _, tag := range response.Items {
+			if tag.IsRetired != nil && *tag.IsRetired {
+				continue
+			}
+
+			// The validator is only returned when getting the tag
+			tagResponse, err := session.IdentityClient.GetTag(ctx, identity.GetTagRequest{
+				TagNamespaceId: namespace.Id,
+				TagName:        tag.Name,
+				RequestMetadata: common.RequestMetadata{
+					RetryPolicy: getDefaultRetryPolicy(d.Connection),
+				},
+			})
+			if err != nil {
+				return err
+			}
+			if validator, ok := tagResponse.Validator.(identity.EnumTagDefinitionValidator); ok {
+				enumValues[identityTagKey(*namespace.Name, *tag.Name)] = validator.Values
+			}
+		}
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+
+	return nil
+}
+
+var (
+	tagComplianceResourceTypePattern = regexp.MustCompile(`^[A-Za-z0-9]+$`)
+	tagComplianceOcidPattern         = regexp.MustCompile(`^ocid1\.[A-Za-z0-9_.-]+$`)
+)
+
+// buildTagComplianceSearchQuery returns the structured search query for the
+// resources of resourceType, optionally in compartmentId. The values are
+// inserted into the query, so anything that isn't a resource type or an OCID
+// is rejected.
+func buildTagComplianceSearchQuery(resourceType string, compartmentId string) (string, bool) {
+	if !tagComplianceResourceTypePattern.MatchString(resourceType) {
+		return "", false
+	}
+	query := fmt.Sprintf("query %s resources", resourceType)
+
+	if compartmentId != "" {
+		if !tagComplianceOcidPattern.MatchString(compartmentId) {
+			return "", false
+		}
+		query = fmt.Sprintf("%s where compartmentId = '%s'", query, compartmentId)
+	}
+
+	return query, true
+}
+
+//// TRANSFORM FUNCTION
+
+func identityTagComplianceTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	item := d.HydrateItem.(identityTagComplianceInfo)
+	return fmt.Sprintf("%s %s.%s", types.SafeString(item.ResourceName), item.TagNamespaceName, item.TagName), nil
+}
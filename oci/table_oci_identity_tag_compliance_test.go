@@ -0,0 +1,29 @@
+package oci
+
+import (
+	"testing"
+)
+
+func TestBuildTagComplianceSearchQuery(t *testing.T) {
+	tests := []struct {
+		resourceType  string
+		compartmentId string
+		expected      string
+		ok            bool
+	}{
+		{"all", "", "query all resources", true},
+		{"instance", "ocid1.compartment.oc1..aaaa", "query instance resources where compartmentId = 'ocid1.compartment.oc1..aaaa'", true},
+		{"all", "ocid1.tenancy.oc1..aaaa", "query all resources where compartmentId = 'ocid1.tenancy.oc1..aaaa'", true},
+		{"all", "ocid1.compartment.oc1..aaaa' || compartmentId != 'x", "", false},
+		{"all", "Prod", "", false},
+		{"instance resources where lifecycleState = 'RUNNING' &&", "", "", false},
+		{"", "", "", false},
+	}
+
+	for _, test := range tests {
+		query, ok := buildTagComplianceSearchQuery(test.resourceType, test.compartmentId)
+		if ok != test.ok || query != test.expected {
+			t.Errorf("%q %q: expected %q %t, got %q %t", test.resourceType, test.compartmentId, test.expected, test.ok, query, ok)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package oci
+
+import (
+	"context"
+
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/identity"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableIdentityUserGroupMembership(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_identity_user_group_membership",
+		Description:      "OCI Identity User Group Membership",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			ParentHydrate: listUsers,
+			Hydrate:       listIdentityUserGroupMemberships,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "user_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "group_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The OCID of the membership.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "user_id",
+				Description: "The OCID of the user.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "user_name",
+				Description: "The name of the user.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "group_id",
+				Description: "The OCID of the group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_state",
+				Description: "The membership's current state.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_created",
+				Description: "Date and time the membership was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeCreated.Time"),
+			},
+			{
+				Name:        "inactive_status",
+				Description: "The detailed status of INACTIVE lifecycleState.",
+				Type:        proto.ColumnType_INT,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+type userGroupMembershipInfo struct {
+	identity.UserGroupMembership
+	UserName *string
+}
+
+//// LIST FUNCTION
+
+func listIdentityUserGroupMemberships(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	user := h.Item.(identity.User)
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given user_id doesn't match
+	if equalQuals["user_id"] != nil && equalQuals["user_id"].GetStringValue() != types.SafeString(user.Id) {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := identityService(ctx, d)
+	if err != nil {
+		logger.Error("oci_identity_user_group_membership.listIdentityUserGroupMemberships", "connection_error", err)
+		return nil, err
+	}
+
+	request := identity.ListUserGroupMembershipsRequest{
+		CompartmentId: &session.TenancyID,
+		UserId:        user.Id,
+		Limit:         types.Int(1000),
+		RequestMetadata: oci_common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	// Check for additional filter
+	if equalQuals["group_id"] != nil {
+		request.GroupId = types.String(equalQuals["group_id"].GetStringValue())
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.IdentityClient.ListUserGroupMemberships(ctx, request)
+		if err != nil {
+			logger.Error("oci_identity_user_group_membership.listIdentityUserGroupMemberships", "api_error", err)
+			return nil, err
+		}
+
+		for _, membership := range response.Items {
+			d.StreamLeafListItem(ctx, userGroupMembershipInfo{membership, user.Name})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
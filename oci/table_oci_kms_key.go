@@ -4,6 +4,7 @@ import (
 	"context"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/keymanagement"
@@ -125,6 +126,24 @@ func tableKmsKey(_ context.Context) *plugin.Table {
 				Transform:   transform.FromField("TimeOfDeletion.Time"),
 				Hydrate:     getKmsKey,
 			},
+			{
+				Name:        "time_of_last_rotation",
+				Description: "The date and time the most recent key version was created, either when the key was created or when it was last rotated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getKmsKeyRotation,
+			},
+			{
+				Name:        "days_since_last_rotation",
+				Description: "The number of days since the most recent key version was created.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getKmsKeyRotation,
+			},
+			{
+				Name:        "rotation_overdue",
+				Description: "True if the key has not been rotated within the kms_key_rotation_threshold_days set in the connection config, which defaults to 365 days.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getKmsKeyRotation,
+			},
 
 			// tags
 			{
@@ -322,6 +341,64 @@ func getKmsKey(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 	return response.Key, nil
 }
 
+type kmsKeyRotation struct {
+	TimeOfLastRotation    *time.Time
+	DaysSinceLastRotation *int
+	RotationOverdue       *bool
+}
+
+// getKmsKeyRotation computes the key's rotation age from its most recent key
+// version
+func getKmsKeyRotation(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getKmsKeyRotation")
+
+	key := h.Item.(KeyInfo)
+	endpoint := key.ManagementEndpoint
+	region := ociRegionNameFromId(*key.Id)
+
+	// key versions of keys being created or deleted cannot be listed
+	if helpers.StringSliceContains([]string{"CREATING", "DELETING", "DELETED"}, types.ToString(key.LifecycleState)) {
+		return kmsKeyRotation{}, nil
+	}
+
+	// Create Session
+	session, err := kmsManagementService(ctx, d, string(region), endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	request := keymanagement.ListKeyVersionsRequest{
+		KeyId:     key.Id,
+		Limit:     types.Int(1),
+		SortBy:    keymanagement.ListKeyVersionsSortByTimecreated,
+		SortOrder: keymanagement.ListKeyVersionsSortOrderDesc,
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.KmsManagementClient.ListKeyVersions(ctx, request)
+	if err != nil {
+		plugin.Logger(ctx).Error("oci_kms_key.getKmsKeyRotation", "api_error", err)
+		return nil, err
+	}
+	if len(response.Items) == 0 || response.Items[0].TimeCreated == nil {
+		return kmsKeyRotation{}, nil
+	}
+
+	threshold := 365
+	ociConfig := GetConfig(d.Connection)
+	if ociConfig.KmsKeyRotationThresholdDays != nil {
+		threshold = *ociConfig.KmsKeyRotationThresholdDays
+	}
+
+	lastRotation := response.Items[0].TimeCreated.Time
+	days := int(time.Since(lastRotation).Hours() / 24)
+	overdue := days > threshold
+
+	return kmsKeyRotation{&lastRotation, &days, &overdue}, nil
+}
+
 //// TRANSFORM FUNCTION
 
 func keyTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
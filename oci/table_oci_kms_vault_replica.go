@@ -0,0 +1,169 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/keymanagement"
+	"github.com/turbot/go-kit/helpers"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableKmsVaultReplica(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_kms_vault_replica",
+		Description:      "OCI KMS Vault Replica",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			ParentHydrate: listKmsVaults,
+			Hydrate:       listKmsVaultReplicas,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "vault_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "vault_id",
+				Description: "The OCID of the source vault.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vault_name",
+				Description: "The display name of the source vault.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "replica_region",
+				Description: "The region the vault is replicated to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VaultReplicaSummary.Region"),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the vault replica.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "crypto_endpoint",
+				Description: "The vault replica's crypto endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "management_endpoint",
+				Description: "The vault replica's management endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VaultReplicaSummary.Region"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VaultId").Transform(ociRegionName),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+type kmsVaultReplicaInfo struct {
+	keymanagement.VaultReplicaSummary
+	VaultId       *string
+	VaultName     *string
+	CompartmentId *string
+}
+
+//// LIST FUNCTION
+
+func listKmsVaultReplicas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	vaultData := h.Item.(keymanagement.VaultSummary)
+
+	// Return nil, if given vault_id doesn't match
+	if d.KeyColumnQuals["vault_id"] != nil && types.SafeString(vaultData.Id) != d.KeyColumnQuals["vault_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// only virtual private vaults can be replicated
+	if vaultData.VaultType != keymanagement.VaultSummaryVaultTypeVirtualPrivate {
+		return nil, nil
+	}
+
+	// skip the API call if vault is any of the below state
+	if helpers.StringSliceContains([]string{"CREATING", "DELETING", "DELETED", "RESTORING"}, types.ToString(vaultData.LifecycleState)) {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := kmsVaultService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_kms_vault_replica.listKmsVaultReplicas", "connection_error", err)
+		return nil, err
+	}
+
+	request := keymanagement.ListVaultReplicasRequest{
+		VaultId: vaultData.Id,
+		Limit:   types.Int(100),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.KmsVaultClient.ListVaultReplicas(ctx, request)
+		if err != nil {
+			logger.Error("oci_kms_vault_replica.listKmsVaultReplicas", "api_error", err)
+			return nil, err
+		}
+
+		for _, replica := range response.Items {
+			d.StreamLeafListItem(ctx, kmsVaultReplicaInfo{replica, vaultData.Id, vaultData.DisplayName, vaultData.CompartmentId})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
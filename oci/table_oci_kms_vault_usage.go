@@ -0,0 +1,271 @@
+package oci
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/keymanagement"
+	"github.com/oracle/oci-go-sdk/v65/limits"
+	"github.com/oracle/oci-go-sdk/v65/vault"
+	"github.com/turbot/go-kit/helpers"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableKmsVaultUsage(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_kms_vault_usage",
+		Description:      "OCI KMS Vault Usage",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			ParentHydrate: listKmsVaults,
+			Hydrate:       listKmsVaultUsages,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "vault_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "vault_id",
+				Description: "The OCID of the vault.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vault_name",
+				Description: "The display name of the vault.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vault_type",
+				Description: "The type of vault.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "key_count",
+				Description: "The number of keys in the vault that persist on a hardware security module (HSM), excluding keys in a deleted state.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "key_version_count",
+				Description: "The number of key versions in the vault that persist on an HSM, excluding key versions in a deleted state.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "software_key_count",
+				Description: "The number of software keys in the vault, excluding keys in a deleted state.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "software_key_version_count",
+				Description: "The number of software key versions in the vault, excluding key versions in a deleted state.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "secret_count",
+				Description: "The number of secrets in the vault, excluding secrets in a deleted state.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getKmsVaultSecretCount,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "service_limits",
+				Description: "The regional and global limit values of the kms service, keyed by limit name, to compare the counts against.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getKmsServiceLimits,
+				Transform:   transform.FromValue(),
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VaultName"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VaultId").Transform(ociRegionName),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+type kmsVaultUsageInfo struct {
+	keymanagement.VaultUsage
+	VaultId       *string
+	VaultName     *string
+	VaultType     keymanagement.VaultSummaryVaultTypeEnum
+	CompartmentId *string
+}
+
+//// LIST FUNCTION
+
+func listKmsVaultUsages(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	vaultData := h.Item.(keymanagement.VaultSummary)
+
+	// Return nil, if given vault_id doesn't match
+	if d.KeyColumnQuals["vault_id"] != nil && types.SafeString(vaultData.Id) != d.KeyColumnQuals["vault_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// skip the API call if vault is any of the below state
+	if helpers.StringSliceContains([]string{"CREATING", "DELETING", "DELETED", "RESTORING"}, types.ToString(vaultData.LifecycleState)) {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := kmsVaultService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_kms_vault_usage.listKmsVaultUsages", "connection_error", err)
+		return nil, err
+	}
+
+	request := keymanagement.GetVaultUsageRequest{
+		VaultId: vaultData.Id,
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.KmsVaultClient.GetVaultUsage(ctx, request)
+	if err != nil {
+		logger.Error("oci_kms_vault_usage.listKmsVaultUsages", "api_error", err)
+		return nil, err
+	}
+
+	d.StreamLeafListItem(ctx, kmsVaultUsageInfo{response.VaultUsage, vaultData.Id, vaultData.DisplayName, vaultData.VaultType, vaultData.CompartmentId})
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+// getKmsVaultSecretCount counts the secrets of the vault across all compartments
+func getKmsVaultSecretCount(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	usage := h.Item.(kmsVaultUsageInfo)
+	region := ociRegionNameFromId(*usage.VaultId)
+
+	// Create Session
+	session, err := vaultService(ctx, d, string(region))
+	if err != nil {
+		logger.Error("oci_kms_vault_usage.getKmsVaultSecretCount", "connection_error", err)
+		return nil, err
+	}
+
+	compartments, err := listAllCompartments(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	count := 0
+	for _, compartment := range compartments {
+		request := vault.ListSecretsRequest{
+			CompartmentId: compartment.Id,
+			VaultId:       usage.VaultId,
+			Limit:         types.Int(1000),
+			RequestMetadata: common.RequestMetadata{
+				RetryPolicy: getDefaultRetryPolicy(d.Connection),
+			},
+		}
+		for {
+			response, err := session.VaultClient.ListSecrets(ctx, request)
+			if err != nil {
+				logger.Error("oci_kms_vault_usage.getKmsVaultSecretCount", "api_error", err)
+				return nil, err
+			}
+			for _, secret := range response.Items {
+				if secret.LifecycleState != vault.SecretSummaryLifecycleStateDeleted {
+					count++
+				}
+			}
+			if response.OpcNextPage == nil {
+				break
+			}
+			request.Page = response.OpcNextPage
+		}
+	}
+
+	return count, nil
+}
+
+// getKmsServiceLimits returns the regional and global limit values of the kms
+// service, cached per region
+func getKmsServiceLimits(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	usage := h.Item.(kmsVaultUsageInfo)
+	region := string(ociRegionNameFromId(*usage.VaultId))
+
+	cacheKey := fmt.Sprintf("getKmsServiceLimits-%s", region)
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(map[string]int64), nil
+	}
+
+	// Create Session
+	session, err := limitsService(ctx, d, region)
+	if err != nil {
+		plugin.Logger(ctx).Error("oci_kms_vault_usage.getKmsServiceLimits", "connection_error", err)
+		return nil, err
+	}
+
+	request := limits.ListLimitValuesRequest{
+		CompartmentId: types.String(session.TenancyID),
+		ServiceName:   types.String("kms"),
+		Limit:         types.Int(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	serviceLimits := map[string]int64{}
+	for {
+		response, err := session.LimitsClient.ListLimitValues(ctx, request)
+		if err != nil {
+			plugin.Logger(ctx).Error("oci_kms_vault_usage.getKmsServiceLimits", "api_error", err)
+			return nil, err
+		}
+		for _, value := range response.Items {
+			if value.ScopeType != limits.LimitValueSummaryScopeTypeAd && value.Name != nil && value.Value != nil {
+				serviceLimits[*value.Name] = *value.Value
+			}
+		}
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+
+	d.ConnectionManager.Cache.Set(cacheKey, serviceLimits)
+
+	return serviceLimits, nil
+}
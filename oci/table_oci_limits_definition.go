@@ -0,0 +1,194 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/limits"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableLimitsDefinition(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_limits_definition",
+		Description:      "OCI Limits Definition",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			Hydrate: listLimitsDefinitions,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "service_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "name",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The resource limit name, used to query the limit value and resource availability.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_name",
+				Description: "The service name of the limit.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The limit description.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "scope_type",
+				Description: "The scope of the resource limit: GLOBAL, REGION or AD.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "are_quotas_supported",
+				Description: "True if quotas can be set on the limit.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "is_resource_availability_supported",
+				Description: "True if resource usage and availability can be queried for the limit.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "is_deprecated",
+				Description: "True if the limit is deprecated.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "is_eligible_for_limit_increase",
+				Description: "True if a limit increase can be requested for the limit.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "is_dynamic",
+				Description: "True if the limit value is adjusted dynamically by the service.",
+				Type:        proto.ColumnType_BOOL,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+type limitDefinitionInfo struct {
+	limits.LimitDefinitionSummary
+	Region string
+}
+
+//// LIST FUNCTION
+
+func listLimitsDefinitions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	equalQuals := d.KeyColumnQuals
+
+	// Create Session
+	session, err := limitsService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_limits_definition.listLimitsDefinitions", "connection_error", err)
+		return nil, err
+	}
+
+	request := limits.ListLimitDefinitionsRequest{
+		CompartmentId: types.String(session.TenancyID),
+		Limit:         types.Int(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	// Check for additional filters
+	if equalQuals["service_name"] != nil {
+		request.ServiceName = types.String(equalQuals["service_name"].GetStringValue())
+	}
+	if equalQuals["name"] != nil {
+		request.Name = types.String(equalQuals["name"].GetStringValue())
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.LimitsClient.ListLimitDefinitions(ctx, request)
+		if err != nil {
+			logger.Error("oci_limits_definition.listLimitsDefinitions", "api_error", err)
+			return nil, err
+		}
+
+		for _, definition := range response.Items {
+			d.StreamListItem(ctx, limitDefinitionInfo{definition, region})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+// listLimitsServiceNames returns the names of the services that have resource limits
+func listLimitsServiceNames(ctx context.Context, d *plugin.QueryData, session *session) ([]string, error) {
+	var names []string
+
+	request := limits.ListServicesRequest{
+		CompartmentId: types.String(session.TenancyID),
+		Limit:         types.Int(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+	for {
+		response, err := session.LimitsClient.ListServices(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		for _, service := range response.Items {
+			names = append(names, types.SafeString(service.Name))
+		}
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+
+	return names, nil
+}
@@ -0,0 +1,293 @@
+package oci
+
+import (
+	"context"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/limits"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableLimitsQuota(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_limits_quota",
+		Description:      "OCI Limits Quota",
+		DefaultTransform: transform.FromCamel(),
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getLimitsQuota,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listLimitsQuotas,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "lifecycle_state",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name you assign to the quota during creation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The OCID of the quota.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description you assign to the quota.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_state",
+				Description: "The quota's current state.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_created",
+				Description: "Date and time the quota was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeCreated.Time"),
+			},
+			{
+				Name:        "statements",
+				Description: "An array of one or more quota statements written in the declarative quota statement language.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getLimitsQuota,
+			},
+			{
+				Name:        "parsed_statements",
+				Description: "The quota statements parsed into action, family, quotas, value, location and conditions. Statements that cannot be parsed carry a parse_error.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getLimitsQuota,
+				Transform:   transform.FromField("Statements").Transform(parseQuotaStatements),
+			},
+			{
+				Name:        "locks",
+				Description: "Locks associated with the quota.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Tags
+			{
+				Name:        "defined_tags",
+				Description: ColumnDescriptionDefinedTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "freeform_tags",
+				Description: ColumnDescriptionFreefromTags,
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(limitsQuotaTags),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+// quotaStatementInfo is a quota statement together with its parsed form
+type quotaStatementInfo struct {
+	Statement  string          `json:"statement"`
+	Parsed     *quotaStatement `json:"parsed,omitempty"`
+	ParseError string          `json:"parse_error,omitempty"`
+}
+
+//// LIST FUNCTION
+
+func listLimitsQuotas(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	equalQuals := d.KeyColumnQuals
+
+	// Quotas are global resources of the tenancy
+	session, err := limitsService(ctx, d, "")
+	if err != nil {
+		logger.Error("oci_limits_quota.listLimitsQuotas", "connection_error", err)
+		return nil, err
+	}
+
+	request := limits.ListQuotasRequest{
+		CompartmentId: types.String(session.TenancyID),
+		Limit:         types.Int(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	// Check for additional filters
+	if equalQuals["name"] != nil {
+		request.Name = types.String(equalQuals["name"].GetStringValue())
+	}
+	if equalQuals["lifecycle_state"] != nil {
+		request.LifecycleState = limits.ListQuotasLifecycleStateEnum(equalQuals["lifecycle_state"].GetStringValue())
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.QuotasClient.ListQuotas(ctx, request)
+		if err != nil {
+			logger.Error("oci_limits_quota.listLimitsQuotas", "api_error", err)
+			return nil, err
+		}
+
+		for _, quota := range response.Items {
+			d.StreamListItem(ctx, quota)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTION
+
+func getLimitsQuota(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	var id string
+	if h.Item != nil {
+		id = *h.Item.(limits.QuotaSummary).Id
+	} else {
+		id = d.KeyColumnQuals["id"].GetStringValue()
+	}
+
+	// handle empty id in get call
+	if strings.TrimSpace(id) == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := limitsService(ctx, d, "")
+	if err != nil {
+		logger.Error("oci_limits_quota.getLimitsQuota", "connection_error", err)
+		return nil, err
+	}
+
+	request := limits.GetQuotaRequest{
+		QuotaId: types.String(id),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.QuotasClient.GetQuota(ctx, request)
+	if err != nil {
+		logger.Error("oci_limits_quota.getLimitsQuota", "api_error", err)
+		return nil, err
+	}
+
+	return response.Quota, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func parseQuotaStatements(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	statements, ok := d.Value.([]string)
+	if !ok {
+		return nil, nil
+	}
+
+	var parsed []quotaStatementInfo
+	for _, statement := range statements {
+		info := quotaStatementInfo{Statement: statement}
+		result, err := parseQuotaStatement(statement)
+		if err != nil {
+			info.ParseError = err.Error()
+		} else {
+			info.Parsed = result
+		}
+		parsed = append(parsed, info)
+	}
+
+	return parsed, nil
+}
+
+// Priority order for tags
+// 1. Defined Tags
+// 2. Free-form tags
+func limitsQuotaTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+
+	var freeformTags map[string]string
+	var definedTags map[string]map[string]interface{}
+
+	switch d.HydrateItem.(type) {
+	case limits.QuotaSummary:
+		quota := d.HydrateItem.(limits.QuotaSummary)
+		freeformTags = quota.FreeformTags
+		definedTags = quota.DefinedTags
+	case limits.Quota:
+		quota := d.HydrateItem.(limits.Quota)
+		freeformTags = quota.FreeformTags
+		definedTags = quota.DefinedTags
+	}
+
+	var tags map[string]interface{}
+
+	if freeformTags != nil {
+		tags = map[string]interface{}{}
+		for k, v := range freeformTags {
+			tags[k] = v
+		}
+	}
+
+	if definedTags != nil {
+		if tags == nil {
+			tags = map[string]interface{}{}
+		}
+		for _, v := range definedTags {
+			for key, value := range v {
+				tags[key] = value
+			}
+		}
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,255 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/limits"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableLimitsResourceAvailability(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_limits_resource_availability",
+		Description:      "OCI Limits Resource Availability",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			Hydrate: listLimitsResourceAvailabilities,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "service_name",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The resource limit name.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_name",
+				Description: "The service name of the limit.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "scope_type",
+				Description: "The scope of the resource limit: GLOBAL, REGION or AD.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "availability_domain",
+				Description: "The availability domain of the limit, for limits with an AD scope.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "limit_value",
+				Description: "The resource limit value.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "used",
+				Description: "The current usage in the given compartment.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Availability.Used"),
+			},
+			{
+				Name:        "available",
+				Description: "The count of available resources, taking into account the limit value and any quotas.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Availability.Available"),
+			},
+			{
+				Name:        "fractional_usage",
+				Description: "The current usage in the given compartment, for resources that can be used in fractional amounts.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Availability.FractionalUsage"),
+			},
+			{
+				Name:        "fractional_availability",
+				Description: "The count of available resources, for resources that can be used in fractional amounts.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Availability.FractionalAvailability"),
+			},
+			{
+				Name:        "effective_quota_value",
+				Description: "The effective quota value for the given compartment, if any quota applies.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Availability.EffectiveQuotaValue"),
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+type limitResourceAvailabilityInfo struct {
+	Name               *string
+	ServiceName        string
+	ScopeType          limits.LimitValueSummaryScopeTypeEnum
+	AvailabilityDomain *string
+	LimitValue         *int64
+	Availability       limits.ResourceAvailability
+	CompartmentId      string
+	Region             string
+}
+
+//// LIST FUNCTION
+
+func listLimitsResourceAvailabilities(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	equalQuals := d.KeyColumnQuals
+	serviceName := equalQuals["service_name"].GetStringValue()
+
+	// Create Session
+	session, err := limitsService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_limits_resource_availability.listLimitsResourceAvailabilities", "connection_error", err)
+		return nil, err
+	}
+
+	// Usage is reported for the tenancy unless a compartment is given
+	compartmentId := session.TenancyID
+	if equalQuals["compartment_id"] != nil {
+		compartmentId = equalQuals["compartment_id"].GetStringValue()
+	}
+
+	// Only some limits support querying resource availability
+	supported := map[string]bool{}
+	definitionRequest := limits.ListLimitDefinitionsRequest{
+		CompartmentId: types.String(session.TenancyID),
+		ServiceName:   types.String(serviceName),
+		Limit:         types.Int(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+	if equalQuals["name"] != nil {
+		definitionRequest.Name = types.String(equalQuals["name"].GetStringValue())
+	}
+	for {
+		response, err := session.LimitsClient.ListLimitDefinitions(ctx, definitionRequest)
+		if err != nil {
+			logger.Error("oci_limits_resource_availability.listLimitsResourceAvailabilities", "api_error", err)
+			return nil, err
+		}
+		for _, definition := range response.Items {
+			if types.BoolValue(definition.IsResourceAvailabilitySupported) {
+				supported[types.SafeString(definition.Name)] = true
+			}
+		}
+		if response.OpcNextPage == nil {
+			break
+		}
+		definitionRequest.Page = response.OpcNextPage
+	}
+
+	request := limits.ListLimitValuesRequest{
+		CompartmentId: types.String(session.TenancyID),
+		ServiceName:   types.String(serviceName),
+		Limit:         types.Int(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+	if equalQuals["name"] != nil {
+		request.Name = types.String(equalQuals["name"].GetStringValue())
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.LimitsClient.ListLimitValues(ctx, request)
+		if err != nil {
+			logger.Error("oci_limits_resource_availability.listLimitsResourceAvailabilities", "api_error", err)
+			return nil, err
+		}
+
+		for _, value := range response.Items {
+			if !supported[types.SafeString(value.Name)] {
+				continue
+			}
+
+			availabilityRequest := limits.GetResourceAvailabilityRequest{
+				ServiceName:   types.String(serviceName),
+				LimitName:     value.Name,
+				CompartmentId: types.String(compartmentId),
+				RequestMetadata: common.RequestMetadata{
+					RetryPolicy: getDefaultRetryPolicy(d.Connection),
+				},
+			}
+			if value.ScopeType == limits.LimitValueSummaryScopeTypeAd {
+				availabilityRequest.AvailabilityDomain = value.AvailabilityDomain
+			}
+
+			availability, err := session.LimitsClient.GetResourceAvailability(ctx, availabilityRequest)
+			if err != nil {
+				logger.Error("oci_limits_resource_availability.listLimitsResourceAvailabilities", "api_error", err)
+				return nil, err
+			}
+
+			d.StreamListItem(ctx, limitResourceAvailabilityInfo{
+				Name:               value.Name,
+				ServiceName:        serviceName,
+				ScopeType:          value.ScopeType,
+				AvailabilityDomain: value.AvailabilityDomain,
+				LimitValue:         value.Value,
+				Availability:       availability.ResourceAvailability,
+				CompartmentId:      compartmentId,
+				Region:             region,
+			})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
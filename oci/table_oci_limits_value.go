@@ -0,0 +1,173 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/limits"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableLimitsValue(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_limits_value",
+		Description:      "OCI Limits Value",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			Hydrate: listLimitsValues,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "service_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "scope_type",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "availability_domain",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The resource limit name.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_name",
+				Description: "The service name of the limit.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "scope_type",
+				Description: "The scope of the resource limit: GLOBAL, REGION or AD.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "availability_domain",
+				Description: "The availability domain of the limit, for limits with an AD scope.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "value",
+				Description: "The resource limit value.",
+				Type:        proto.ColumnType_INT,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+type limitValueInfo struct {
+	limits.LimitValueSummary
+	ServiceName string
+	Region      string
+}
+
+//// LIST FUNCTION
+
+func listLimitsValues(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	equalQuals := d.KeyColumnQuals
+
+	// Create Session
+	session, err := limitsService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_limits_value.listLimitsValues", "connection_error", err)
+		return nil, err
+	}
+
+	// Limit values can only be listed per service
+	var serviceNames []string
+	if equalQuals["service_name"] != nil {
+		serviceNames = []string{equalQuals["service_name"].GetStringValue()}
+	} else {
+		serviceNames, err = listLimitsServiceNames(ctx, d, session)
+		if err != nil {
+			logger.Error("oci_limits_value.listLimitsValues", "api_error", err)
+			return nil, err
+		}
+	}
+
+	for _, serviceName := range serviceNames {
+		request := limits.ListLimitValuesRequest{
+			CompartmentId: types.String(session.TenancyID),
+			ServiceName:   types.String(serviceName),
+			Limit:         types.Int(1000),
+			RequestMetadata: common.RequestMetadata{
+				RetryPolicy: getDefaultRetryPolicy(d.Connection),
+			},
+		}
+
+		// Check for additional filters
+		if equalQuals["name"] != nil {
+			request.Name = types.String(equalQuals["name"].GetStringValue())
+		}
+		if equalQuals["scope_type"] != nil {
+			request.ScopeType = limits.ListLimitValuesScopeTypeEnum(equalQuals["scope_type"].GetStringValue())
+		}
+		if equalQuals["availability_domain"] != nil {
+			request.AvailabilityDomain = types.String(equalQuals["availability_domain"].GetStringValue())
+		}
+
+		pagesLeft := true
+		for pagesLeft {
+			response, err := session.LimitsClient.ListLimitValues(ctx, request)
+			if err != nil {
+				logger.Error("oci_limits_value.listLimitsValues", "api_error", err)
+				return nil, err
+			}
+
+			for _, value := range response.Items {
+				d.StreamListItem(ctx, limitValueInfo{value, serviceName, region})
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+			if response.OpcNextPage != nil {
+				request.Page = response.OpcNextPage
+			} else {
+				pagesLeft = false
+			}
+		}
+	}
+
+	return nil, nil
+}
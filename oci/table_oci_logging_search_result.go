@@ -0,0 +1,300 @@
+package oci
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/loggingsearch"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableLoggingSearchResult(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_logging_search_result",
+		Description:      "OCI Logging Search Result",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			Hydrate: listLoggingSearchResults,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "search_query",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "time_start",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "time_end",
+					Require: plugin.Required,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "search_query",
+				Description: "The query, in the Logging Query Language, the results were searched with.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_start",
+				Description: "The start of the time range searched.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "time_end",
+				Description: "The end of the time range searched.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "datetime",
+				Description: "The time the log entry was recorded.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "id",
+				Description: "The unique identifier of the log entry.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source",
+				Description: "The source of the log entry, e.g. the resource that emitted it.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the log entry, e.g. com.oraclecloud.ComputeApi.GetInstance or com.oraclecloud.vcn.flowlogs.DataEvent.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "subject",
+				Description: "The subject of the log entry.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "spec_version",
+				Description: "The version of the CloudEvents specification the log entry follows.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time",
+				Description: "The time of the event the log entry describes.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "ingested_time",
+				Description: "The time the log entry was ingested by the Logging service.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "log_id",
+				Description: "The OCID of the log the entry belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "log_group_id",
+				Description: "The OCID of the log group the entry belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "log_content_data",
+				Description: "The payload of the log entry, e.g. the audit event details or flow log record.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "data",
+				Description: "The raw search result, including any fields projected by the query.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+// loggingSearchResultInfo is a search result with the standard fields of the
+// log entry parsed out of its data
+type loggingSearchResultInfo struct {
+	SearchQuery    string
+	TimeStart      time.Time
+	TimeEnd        time.Time
+	Datetime       *time.Time
+	Id             *string
+	Source         *string
+	Type           *string
+	Subject        *string
+	SpecVersion    *string
+	Time           *time.Time
+	IngestedTime   *time.Time
+	LogId          *string
+	LogGroupId     *string
+	LogContentData interface{}
+	Data           interface{}
+	Region         string
+	CompartmentId  *string
+}
+
+//// LIST FUNCTION
+
+func listLoggingSearchResults(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	equalQuals := d.KeyColumnQuals
+
+	searchQuery := equalQuals["search_query"].GetStringValue()
+	timeStart := equalQuals["time_start"].GetTimestampValue().AsTime()
+	timeEnd := equalQuals["time_end"].GetTimestampValue().AsTime()
+	if !timeEnd.After(timeStart) {
+		return nil, errors.New("time_end must be after time_start")
+	}
+
+	// Create Session
+	session, err := loggingSearchService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_logging_search_result.listLoggingSearchResults", "connection_error", err)
+		return nil, err
+	}
+
+	request := loggingsearch.SearchLogsRequest{
+		SearchLogsDetails: loggingsearch.SearchLogsDetails{
+			TimeStart:   &common.SDKTime{Time: timeStart},
+			TimeEnd:     &common.SDKTime{Time: timeEnd},
+			SearchQuery: types.String(searchQuery),
+		},
+		Limit: types.Int(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	// Check for limit
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < int64(*request.Limit) {
+			request.Limit = types.Int(int(*limit))
+		}
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.LogSearchClient.SearchLogs(ctx, request)
+		if err != nil {
+			logger.Error("oci_logging_search_result.listLoggingSearchResults", "api_error", err)
+			return nil, err
+		}
+
+		for _, result := range response.Results {
+			item := buildLoggingSearchResultInfo(result)
+			item.SearchQuery = searchQuery
+			item.TimeStart = timeStart
+			item.TimeEnd = timeEnd
+			item.Region = region
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+// buildLoggingSearchResultInfo parses the standard fields of a log entry. Queries
+// that project their own fields return results without these, in which case
+// only the raw data is set.
+func buildLoggingSearchResultInfo(result loggingsearch.SearchResult) loggingSearchResultInfo {
+	item := loggingSearchResultInfo{}
+	if result.Data == nil {
+		return item
+	}
+	item.Data = *result.Data
+
+	data, ok := item.Data.(map[string]interface{})
+	if !ok {
+		return item
+	}
+	if datetime, ok := data["datetime"].(float64); ok {
+		t := time.UnixMilli(int64(datetime)).UTC()
+		item.Datetime = &t
+	}
+
+	content, ok := data["logContent"].(map[string]interface{})
+	if !ok {
+		return item
+	}
+	item.Id = logSearchString(content, "id")
+	item.Source = logSearchString(content, "source")
+	item.Type = logSearchString(content, "type")
+	item.Subject = logSearchString(content, "subject")
+	item.SpecVersion = logSearchString(content, "specversion")
+	item.Time = logSearchTime(content, "time")
+	item.LogContentData = content["data"]
+
+	if oracle, ok := content["oracle"].(map[string]interface{}); ok {
+		item.CompartmentId = logSearchString(oracle, "compartmentid")
+		item.LogGroupId = logSearchString(oracle, "loggroupid")
+		item.LogId = logSearchString(oracle, "logid")
+		item.IngestedTime = logSearchTime(oracle, "ingestedtime")
+	}
+
+	return item
+}
+
+func logSearchString(fields map[string]interface{}, key string) *string {
+	if value, ok := fields[key].(string); ok && value != "" {
+		return types.String(value)
+	}
+	return nil
+}
+
+func logSearchTime(fields map[string]interface{}, key string) *time.Time {
+	value, ok := fields[key].(string)
+	if !ok {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,224 @@
+package oci
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/queue"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// the maximum number of messages returned by a GetMessages call
+const queueMessagePeekLimit = 32
+
+//// TABLE DEFINITION
+
+func tableQueueMessage(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_queue_message",
+		Description:      "OCI Queue Message. Requires allow_queue_message_peek = true in the connection config, otherwise queries return an error. Each query counts as a delivery of the returned messages and can move them to the dead letter queue.",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			Hydrate: listQueueMessages,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "queue_id",
+					Require: plugin.Required,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "queue_id",
+				Description: "The OCID of the queue.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "queue_name",
+				Description: "The name of the queue.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The id of the message. It is only meant for tracing and debugging.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "content",
+				Description: "The content of the message.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "delivery_count",
+				Description: "The number of times the message has been delivered to a consumer, including the delivery to this query. Peeking increases the count, and a message is moved to the dead letter queue once it reaches the queue's dead_letter_queue_delivery_count.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "visible_after",
+				Description: "The date and time after which the message is visible to consumers.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("VisibleAfter.Time"),
+			},
+			{
+				Name:        "expire_after",
+				Description: "The date and time after which the message is automatically deleted.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ExpireAfter.Time"),
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+// queueMessageInfo is a message peeked from a queue. The receipt of the
+// message is left out, as it allows deleting the message.
+type queueMessageInfo struct {
+	QueueId       *string
+	QueueName     *string
+	Id            *int64
+	Content       *string
+	DeliveryCount *int
+	VisibleAfter  *oci_common.SDKTime
+	ExpireAfter   *oci_common.SDKTime
+	Region        string
+	CompartmentId *string
+}
+
+//// LIST FUNCTION
+
+// listQueueMessages peeks at the messages of a queue. The messages are read
+// with a visibility of 0 seconds, so they stay available to the consumers of
+// the queue. Since peeked messages stay visible, a single call is made.
+func listQueueMessages(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	logger.Trace("listQueueMessages", "OCI_REGION", region)
+
+	if err := checkQueueMessagePeekAllowed(GetConfig(d.Connection)); err != nil {
+		return nil, err
+	}
+
+	queueId := d.KeyColumnQuals["queue_id"].GetStringValue()
+
+	// Queues are only served by the region in their OCID
+	parts := strings.Split(queueId, ".")
+	if len(parts) < 4 || string(oci_common.StringToRegion(parts[3])) != region {
+		return nil, nil
+	}
+
+	// Create Session
+	adminSession, err := queueService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_queue_message.listQueueMessages", "connection_error", err)
+		return nil, err
+	}
+
+	queueResponse, err := adminSession.QueueAdminClient.GetQueue(ctx, queue.GetQueueRequest{
+		QueueId: types.String(queueId),
+		RequestMetadata: oci_common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	})
+	if err != nil {
+		if ociErr, ok := err.(oci_common.ServiceError); ok && ociErr.GetHTTPStatusCode() == 404 {
+			return nil, nil
+		}
+		logger.Error("oci_queue_message.listQueueMessages", "api_error", err)
+		return nil, err
+	}
+	queueItem := queueResponse.Queue
+
+	session, err := queueMessageService(ctx, d, region, types.SafeString(queueItem.MessagesEndpoint))
+	if err != nil {
+		logger.Error("oci_queue_message.listQueueMessages", "connection_error", err)
+		return nil, err
+	}
+
+	// Build request parameters
+	request := queue.GetMessagesRequest{
+		QueueId:             queueItem.Id,
+		VisibilityInSeconds: types.Int(0),
+		TimeoutInSeconds:    types.Int(0),
+		Limit:               types.Int(queueMessagePeekLimit),
+		RequestMetadata: oci_common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	// Check for limit
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < int64(*request.Limit) {
+			request.Limit = types.Int(int(*limit))
+		}
+	}
+
+	response, err := session.QueueClient.GetMessages(ctx, request)
+	if err != nil {
+		logger.Error("oci_queue_message.listQueueMessages", "api_error", err)
+		return nil, err
+	}
+
+	for _, message := range response.Messages {
+		d.StreamListItem(ctx, queueMessageInfo{
+			QueueId:       queueItem.Id,
+			QueueName:     queueItem.DisplayName,
+			Id:            message.Id,
+			Content:       message.Content,
+			DeliveryCount: message.DeliveryCount,
+			VisibleAfter:  message.VisibleAfter,
+			ExpireAfter:   message.ExpireAfter,
+			Region:        region,
+			CompartmentId: queueItem.CompartmentId,
+		})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// checkQueueMessagePeekAllowed returns an error unless the connection config
+// allows peeking at queue messages. A peek counts as a delivery of the returned
+// messages, so they are only read when explicitly allowed.
+func checkQueueMessagePeekAllowed(config ociConfig) error {
+	if config.AllowQueueMessagePeek == nil || !*config.AllowQueueMessagePeek {
+		return errors.New("the oci_queue_message table requires allow_queue_message_peek = true in the connection config, as each query counts as a delivery of the returned messages")
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package oci
+
+import (
+	"testing"
+
+	"github.com/turbot/go-kit/types"
+)
+
+func TestCheckQueueMessagePeekAllowed(t *testing.T) {
+	tests := []struct {
+		name          string
+		config        ociConfig
+		expectedError bool
+	}{
+		{name: "unset", config: ociConfig{}, expectedError: true},
+		{name: "false", config: ociConfig{AllowQueueMessagePeek: types.Bool(false)}, expectedError: true},
+		{name: "true", config: ociConfig{AllowQueueMessagePeek: types.Bool(true)}},
+	}
+
+	for _, test := range tests {
+		if err := checkQueueMessagePeekAllowed(test.config); (err != nil) != test.expectedError {
+			t.Errorf("%s: expected error %t, got %v", test.name, test.expectedError, err)
+		}
+	}
+}
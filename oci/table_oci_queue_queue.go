@@ -117,6 +117,48 @@ func tableQueueQueue(_ context.Context) *plugin.Table {
 				Hydrate:     getQueue,
 				Type:        proto.ColumnType_INT,
 			},
+			{
+				Name:        "visible_messages",
+				Description: "The approximate number of messages in the queue that are available for delivery.",
+				Hydrate:     getQueueStats,
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Queue.VisibleMessages"),
+			},
+			{
+				Name:        "in_flight_messages",
+				Description: "The approximate number of messages in the queue that were delivered to a consumer but not yet deleted.",
+				Hydrate:     getQueueStats,
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Queue.InFlightMessages"),
+			},
+			{
+				Name:        "size_in_bytes",
+				Description: "The approximate size of the visible and in-flight messages in the queue, in bytes.",
+				Hydrate:     getQueueStats,
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Queue.SizeInBytes"),
+			},
+			{
+				Name:        "dead_letter_queue_visible_messages",
+				Description: "The approximate number of messages in the dead letter queue that are available for delivery.",
+				Hydrate:     getQueueStats,
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Dlq.VisibleMessages"),
+			},
+			{
+				Name:        "dead_letter_queue_in_flight_messages",
+				Description: "The approximate number of messages in the dead letter queue that were delivered to a consumer but not yet deleted.",
+				Hydrate:     getQueueStats,
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Dlq.InFlightMessages"),
+			},
+			{
+				Name:        "dead_letter_queue_size_in_bytes",
+				Description: "The approximate size of the visible and in-flight messages in the dead letter queue, in bytes.",
+				Hydrate:     getQueueStats,
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Dlq.SizeInBytes"),
+			},
 
 			// tags
 			{
@@ -279,6 +321,53 @@ func getQueue(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (
 	return response.Queue, nil
 }
 
+// getQueueStats returns the message counts of the queue and its dead letter
+// queue. Stats are served by the data plane at the messages endpoint of the
+// queue rather than by the queue admin API.
+func getQueueStats(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	logger.Trace("getQueueStats", "OCI_REGION", region)
+
+	var id, endpoint string
+	switch item := h.Item.(type) {
+	case queue.QueueSummary:
+		id = types.SafeString(item.Id)
+		endpoint = types.SafeString(item.MessagesEndpoint)
+	case queue.Queue:
+		id = types.SafeString(item.Id)
+		endpoint = types.SafeString(item.MessagesEndpoint)
+	}
+
+	if id == "" || endpoint == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := queueMessageService(ctx, d, region, endpoint)
+	if err != nil {
+		logger.Error("oci_queue_queue.getQueueStats", "connection_error", err)
+		return nil, err
+	}
+
+	request := queue.GetStatsRequest{
+		QueueId: types.String(id),
+		RequestMetadata: oci_common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+	response, err := session.QueueClient.GetStats(ctx, request)
+	if err != nil {
+		if ociErr, ok := err.(oci_common.ServiceError); ok && ociErr.GetHTTPStatusCode() == 404 {
+			return nil, nil
+		}
+		logger.Error("oci_queue_queue.getQueueStats", "api_error", err)
+		return nil, err
+	}
+
+	return response.QueueStats, nil
+}
+
 // Build additional filters
 func buildQueueFilters(equalQuals plugin.KeyColumnEqualsQualMap, logger hclog.Logger) (queue.ListQueuesRequest, bool) {
 	request := queue.ListQueuesRequest{}
@@ -0,0 +1,281 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/resourcemanager"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableResourceManagerConfigurationSourceProvider(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_resourcemanager_configuration_source_provider",
+		Description:      "OCI Resource Manager Configuration Source Provider",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			Hydrate: listResourceManagerConfigurationSourceProviders,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "display_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "config_source_provider_type",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "display_name",
+				Description: "Human-readable display name for the configuration source provider.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The OCID of the configuration source provider.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "config_source_provider_type",
+				Description: "The type of configuration source provider, e.g. GITHUB_ACCESS_TOKEN, GITLAB_ACCESS_TOKEN, BITBUCKET_CLOUD_USERNAME_APPPASSWORD or BITBUCKET_SERVER_ACCESS_TOKEN.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_state",
+				Description: "The current lifecycle state of the configuration source provider.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "api_endpoint",
+				Description: "The API endpoint of the code repository.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "General description of the configuration source provider.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_created",
+				Description: "The date and time when the configuration source provider was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeCreated.Time"),
+			},
+			{
+				Name:        "private_server_config_details",
+				Description: "The private endpoint and certificate used to reach a code repository on a private server.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// tags
+			{
+				Name:        "defined_tags",
+				Description: ColumnDescriptionDefinedTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "freeform_tags",
+				Description: ColumnDescriptionFreefromTags,
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(resourceManagerConfigurationSourceProviderTags),
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id").Transform(ociRegionName),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+// configurationSourceProviderInfo flattens the provider specific summary types,
+// which share all their fields
+type configurationSourceProviderInfo struct {
+	Id                         *string
+	CompartmentId              *string
+	DisplayName                *string
+	Description                *string
+	TimeCreated                *common.SDKTime
+	LifecycleState             resourcemanager.ConfigurationSourceProviderLifecycleStateEnum
+	ConfigSourceProviderType   string
+	ApiEndpoint                *string
+	PrivateServerConfigDetails *resourcemanager.PrivateServerConfigDetails
+	FreeformTags               map[string]string
+	DefinedTags                map[string]map[string]interface{}
+}
+
+//// LIST FUNCTION
+
+func listResourceManagerConfigurationSourceProviders(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Trace("listResourceManagerConfigurationSourceProviders", "Compartment", compartment, "OCI_REGION", region)
+
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given compartment_id doesn't match
+	if equalQuals["compartment_id"] != nil && compartment != equalQuals["compartment_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := resourceManagerService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_resourcemanager_configuration_source_provider.listResourceManagerConfigurationSourceProviders", "connection_error", err)
+		return nil, err
+	}
+
+	// Build request parameters
+	request := buildResourceManagerConfigurationSourceProviderFilters(equalQuals)
+	request.CompartmentId = types.String(compartment)
+	request.Limit = types.Int(1000)
+	request.RequestMetadata = common.RequestMetadata{
+		RetryPolicy: getDefaultRetryPolicy(d.Connection),
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.ResourceManagerClient.ListConfigurationSourceProviders(ctx, request)
+		if err != nil {
+			logger.Error("oci_resourcemanager_configuration_source_provider.listResourceManagerConfigurationSourceProviders", "api_error", err)
+			return nil, err
+		}
+
+		for _, provider := range response.Items {
+			d.StreamListItem(ctx, buildConfigurationSourceProviderInfo(provider))
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+func buildConfigurationSourceProviderInfo(provider resourcemanager.ConfigurationSourceProviderSummary) configurationSourceProviderInfo {
+	item := configurationSourceProviderInfo{
+		Id:                         provider.GetId(),
+		CompartmentId:              provider.GetCompartmentId(),
+		DisplayName:                provider.GetDisplayName(),
+		Description:                provider.GetDescription(),
+		TimeCreated:                provider.GetTimeCreated(),
+		LifecycleState:             provider.GetLifecycleState(),
+		PrivateServerConfigDetails: provider.GetPrivateServerConfigDetails(),
+		FreeformTags:               provider.GetFreeformTags(),
+		DefinedTags:                provider.GetDefinedTags(),
+	}
+
+	switch provider := provider.(type) {
+	case resourcemanager.GithubAccessTokenConfigurationSourceProviderSummary:
+		item.ConfigSourceProviderType = "GITHUB_ACCESS_TOKEN"
+		item.ApiEndpoint = provider.ApiEndpoint
+	case resourcemanager.GitlabAccessTokenConfigurationSourceProviderSummary:
+		item.ConfigSourceProviderType = "GITLAB_ACCESS_TOKEN"
+		item.ApiEndpoint = provider.ApiEndpoint
+	case resourcemanager.BitbucketCloudUsernameAppPasswordConfigurationSourceProviderSummary:
+		item.ConfigSourceProviderType = "BITBUCKET_CLOUD_USERNAME_APPPASSWORD"
+		item.ApiEndpoint = provider.ApiEndpoint
+	case resourcemanager.BitbucketServerAccessTokenConfigurationSourceProviderSummary:
+		item.ConfigSourceProviderType = "BITBUCKET_SERVER_ACCESS_TOKEN"
+		item.ApiEndpoint = provider.ApiEndpoint
+	}
+
+	return item
+}
+
+//// TRANSFORM FUNCTION
+
+func resourceManagerConfigurationSourceProviderTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	provider := d.HydrateItem.(configurationSourceProviderInfo)
+
+	var tags map[string]interface{}
+
+	if provider.FreeformTags != nil {
+		tags = map[string]interface{}{}
+		for k, v := range provider.FreeformTags {
+			tags[k] = v
+		}
+	}
+
+	if provider.DefinedTags != nil {
+		if tags == nil {
+			tags = map[string]interface{}{}
+		}
+		for _, v := range provider.DefinedTags {
+			for key, value := range v {
+				tags[key] = value
+			}
+		}
+	}
+
+	return tags, nil
+}
+
+// Build additional filters
+func buildResourceManagerConfigurationSourceProviderFilters(equalQuals plugin.KeyColumnEqualsQualMap) resourcemanager.ListConfigurationSourceProvidersRequest {
+	request := resourcemanager.ListConfigurationSourceProvidersRequest{}
+
+	if equalQuals["id"] != nil {
+		request.ConfigurationSourceProviderId = types.String(equalQuals["id"].GetStringValue())
+	}
+	if equalQuals["display_name"] != nil {
+		request.DisplayName = types.String(equalQuals["display_name"].GetStringValue())
+	}
+	if equalQuals["config_source_provider_type"] != nil {
+		request.ConfigSourceProviderType = types.String(equalQuals["config_source_provider_type"].GetStringValue())
+	}
+
+	return request
+}
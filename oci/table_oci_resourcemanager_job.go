@@ -0,0 +1,326 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/resourcemanager"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableResourceManagerJob(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_resourcemanager_job",
+		Description:      "OCI Resource Manager Job",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			Hydrate: listResourceManagerJobs,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "stack_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "display_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "lifecycle_state",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "display_name",
+				Description: "The job's display name.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The OCID of the job.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "stack_id",
+				Description: "The OCID of the stack that is associated with the job.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "operation",
+				Description: "The type of job executing, e.g. PLAN, APPLY, DESTROY or IMPORT_TF_STATE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_state",
+				Description: "The current lifecycle state of the job.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resolved_plan_job_id",
+				Description: "The plan job OCID that was used, if applicable, for an apply job.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_created",
+				Description: "The date and time when the job was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeCreated.Time"),
+			},
+			{
+				Name:        "time_finished",
+				Description: "The date and time when the job stopped running, irrespective of whether the job ran successfully.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeFinished.Time"),
+			},
+			{
+				Name:        "working_directory",
+				Description: "File path to the directory from which Terraform runs.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getResourceManagerJob,
+			},
+			{
+				Name:        "is_provider_upgrade_required",
+				Description: "Specifies whether or not to upgrade provider versions.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getResourceManagerJob,
+			},
+			{
+				Name:        "apply_job_plan_resolution",
+				Description: "Specifies which plan job provides the execution plan for an apply job.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "job_operation_details",
+				Description: "Job details that are specific to the operation type.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "failure_details",
+				Description: "The reason and message of a failed job.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getResourceManagerJob,
+			},
+			{
+				Name:        "cancellation_details",
+				Description: "The details of a canceled job.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getResourceManagerJob,
+			},
+			{
+				Name:        "config_source",
+				Description: "The configuration source of the Terraform configuration used by the job.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getResourceManagerJob,
+			},
+
+			// tags
+			{
+				Name:        "defined_tags",
+				Description: ColumnDescriptionDefinedTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "freeform_tags",
+				Description: ColumnDescriptionFreefromTags,
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(resourceManagerJobTags),
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id").Transform(ociRegionName),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listResourceManagerJobs(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Trace("listResourceManagerJobs", "Compartment", compartment, "OCI_REGION", region)
+
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given compartment_id doesn't match
+	if equalQuals["compartment_id"] != nil && compartment != equalQuals["compartment_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := resourceManagerService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_resourcemanager_job.listResourceManagerJobs", "connection_error", err)
+		return nil, err
+	}
+
+	// Build request parameters
+	request, isValid := buildResourceManagerJobFilters(equalQuals)
+	if !isValid {
+		return nil, nil
+	}
+	request.CompartmentId = types.String(compartment)
+	request.Limit = types.Int(1000)
+	request.RequestMetadata = common.RequestMetadata{
+		RetryPolicy: getDefaultRetryPolicy(d.Connection),
+	}
+
+	// Check for limit
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < int64(*request.Limit) {
+			request.Limit = types.Int(int(*limit))
+		}
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.ResourceManagerClient.ListJobs(ctx, request)
+		if err != nil {
+			logger.Error("oci_resourcemanager_job.listResourceManagerJobs", "api_error", err)
+			return nil, err
+		}
+
+		for _, job := range response.Items {
+			d.StreamListItem(ctx, job)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTION
+
+func getResourceManagerJob(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	job := h.Item.(resourcemanager.JobSummary)
+	region := string(ociRegionNameFromId(*job.Id))
+
+	// Create Session
+	session, err := resourceManagerService(ctx, d, region)
+	if err != nil {
+		plugin.Logger(ctx).Error("oci_resourcemanager_job.getResourceManagerJob", "connection_error", err)
+		return nil, err
+	}
+
+	request := resourcemanager.GetJobRequest{
+		JobId: job.Id,
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.ResourceManagerClient.GetJob(ctx, request)
+	if err != nil {
+		plugin.Logger(ctx).Error("oci_resourcemanager_job.getResourceManagerJob", "api_error", err)
+		return nil, err
+	}
+
+	return response.Job, nil
+}
+
+//// TRANSFORM FUNCTION
+
+func resourceManagerJobTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	job := d.HydrateItem.(resourcemanager.JobSummary)
+
+	var tags map[string]interface{}
+
+	if job.FreeformTags != nil {
+		tags = map[string]interface{}{}
+		for k, v := range job.FreeformTags {
+			tags[k] = v
+		}
+	}
+
+	if job.DefinedTags != nil {
+		if tags == nil {
+			tags = map[string]interface{}{}
+		}
+		for _, v := range job.DefinedTags {
+			for key, value := range v {
+				tags[key] = value
+			}
+		}
+	}
+
+	return tags, nil
+}
+
+// Build additional filters
+func buildResourceManagerJobFilters(equalQuals plugin.KeyColumnEqualsQualMap) (resourcemanager.ListJobsRequest, bool) {
+	request := resourcemanager.ListJobsRequest{}
+
+	if equalQuals["id"] != nil {
+		request.Id = types.String(equalQuals["id"].GetStringValue())
+	}
+	if equalQuals["stack_id"] != nil {
+		request.StackId = types.String(equalQuals["stack_id"].GetStringValue())
+	}
+	if equalQuals["display_name"] != nil {
+		request.DisplayName = types.String(equalQuals["display_name"].GetStringValue())
+	}
+	if equalQuals["lifecycle_state"] != nil {
+		lifecycleState, ok := resourcemanager.GetMappingJobLifecycleStateEnum(equalQuals["lifecycle_state"].GetStringValue())
+		if !ok {
+			return request, false
+		}
+		request.LifecycleState = lifecycleState
+	}
+
+	return request, true
+}
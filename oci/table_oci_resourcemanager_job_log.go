@@ -0,0 +1,206 @@
+package oci
+
+import (
+	"context"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/resourcemanager"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableResourceManagerJobLog(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_resourcemanager_job_log",
+		Description:      "OCI Resource Manager Job Log",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			Hydrate: listResourceManagerJobLogs,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "job_id",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "type",
+					Require: plugin.Optional,
+				},
+				{
+					Name:      "timestamp",
+					Require:   plugin.Optional,
+					Operators: []string{">", ">=", "=", "<", "<="},
+				},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "job_id",
+				Description: "The OCID of the job.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "line_number",
+				Description: "The position of the log entry in the log of the job, starting from 1. Lines are numbered over the whole log, so the number of an entry doesn't depend on the where clause.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "timestamp",
+				Description: "Time the log entry was logged.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Timestamp.Time"),
+			},
+			{
+				Name:        "level",
+				Description: "Specifies the severity level of the log entry, e.g. TRACE, DEBUG, INFO, WARN, ERROR or FATAL.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "Specifies the log type for the log entry. Currently the only value is TERRAFORM_CONSOLE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "message",
+				Description: "The log entry value.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("JobId").Transform(ociRegionName),
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+type resourceManagerJobLogInfo struct {
+	resourcemanager.LogEntry
+	JobId      string
+	LineNumber int
+}
+
+//// LIST FUNCTION
+
+func listResourceManagerJobLogs(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	jobId := d.KeyColumnQuals["job_id"].GetStringValue()
+
+	// handle empty or malformed job id in list call
+	if strings.Count(jobId, ".") < 4 {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := resourceManagerService(ctx, d, string(ociRegionNameFromId(jobId)))
+	if err != nil {
+		logger.Error("oci_resourcemanager_job_log.listResourceManagerJobLogs", "connection_error", err)
+		return nil, err
+	}
+
+	request := resourcemanager.GetJobLogsRequest{
+		JobId:     types.String(jobId),
+		SortOrder: resourcemanager.GetJobLogsSortOrderAsc,
+		Limit:     types.Int(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	// The type and timestamp quals are applied here rather than by the API,
+	// so that line numbers count every entry of the log
+	var logType *resourcemanager.LogEntryTypeEnum
+	if d.KeyColumnQuals["type"] != nil {
+		value, ok := resourcemanager.GetMappingLogEntryTypeEnum(d.KeyColumnQuals["type"].GetStringValue())
+		if !ok {
+			return nil, nil
+		}
+		logType = &value
+	}
+
+	lineNumber := 0
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.ResourceManagerClient.GetJobLogs(ctx, request)
+		if err != nil {
+			logger.Error("oci_resourcemanager_job_log.listResourceManagerJobLogs", "api_error", err)
+			return nil, err
+		}
+
+		for _, entry := range response.Items {
+			lineNumber++
+			if logType != nil && entry.Type != *logType {
+				continue
+			}
+			if !resourceManagerJobLogTimestampMatches(entry, d.Quals["timestamp"]) {
+				continue
+			}
+			d.StreamListItem(ctx, resourceManagerJobLogInfo{entry, jobId, lineNumber})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+// resourceManagerJobLogTimestampMatches reports whether the timestamp of the
+// log entry satisfies every timestamp qual
+func resourceManagerJobLogTimestampMatches(entry resourcemanager.LogEntry, quals *plugin.KeyColumnQuals) bool {
+	if quals == nil {
+		return true
+	}
+	if entry.Timestamp == nil {
+		return false
+	}
+
+	timestamp := entry.Timestamp.Time
+	for _, q := range quals.Quals {
+		value := q.Value.GetTimestampValue().AsTime()
+		switch q.Operator {
+		case ">":
+			if !timestamp.After(value) {
+				return false
+			}
+		case ">=":
+			if timestamp.Before(value) {
+				return false
+			}
+		case "<":
+			if !timestamp.Before(value) {
+				return false
+			}
+		case "<=":
+			if timestamp.After(value) {
+				return false
+			}
+		case "=":
+			if !timestamp.Equal(value) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,41 @@
+package oci
+
+import (
+	"testing"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/resourcemanager"
+)
+
+func TestResourceManagerJobLogTimestampMatches(t *testing.T) {
+	start := time.Date(2023, 1, 10, 12, 0, 0, 0, time.UTC)
+	entry := resourcemanager.LogEntry{Timestamp: &common.SDKTime{Time: start}}
+
+	tests := []struct {
+		name     string
+		entry    resourcemanager.LogEntry
+		quals    []interface{}
+		expected bool
+	}{
+		{"no quals", entry, nil, true},
+		{"equal", entry, []interface{}{"=", start}, true},
+		{"not equal", entry, []interface{}{"=", start.Add(time.Second)}, false},
+		{"inclusive bounds", entry, []interface{}{">=", start, "<=", start}, true},
+		{"exclusive lower bound", entry, []interface{}{">", start}, false},
+		{"exclusive upper bound", entry, []interface{}{"<", start}, false},
+		{"within range", entry, []interface{}{">", start.Add(-time.Hour), "<", start.Add(time.Hour)}, true},
+		{"after range", entry, []interface{}{"<", start.Add(-time.Hour)}, false},
+		{"no timestamp", resourcemanager.LogEntry{}, []interface{}{">", start}, false},
+	}
+
+	for _, test := range tests {
+		quals := usageTimeQuals("timestamp", test.quals...)
+		if test.quals == nil {
+			quals = nil
+		}
+		if actual := resourceManagerJobLogTimestampMatches(test.entry, quals); actual != test.expected {
+			t.Errorf("%s: expected %t, got %t", test.name, test.expected, actual)
+		}
+	}
+}
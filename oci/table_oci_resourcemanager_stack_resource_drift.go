@@ -0,0 +1,214 @@
+package oci
+
+import (
+	"context"
+	"sort"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/resourcemanager"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableResourceManagerStackResourceDrift(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_resourcemanager_stack_resource_drift",
+		Description:      "OCI Resource Manager Stack Resource Drift",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			ParentHydrate: listResourceManagerStacks,
+			Hydrate:       listResourceManagerStackResourceDrifts,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "stack_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "resource_drift_status",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "stack_id",
+				Description: "The OCID of the stack.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_name",
+				Description: "The name of the resource as defined in the stack.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_id",
+				Description: "The OCID of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_type",
+				Description: "The provider resource type, e.g. oci_core_instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_drift_status",
+				Description: "The drift status of the resource, e.g. NOT_CHECKED, IN_SYNC, MODIFIED or DELETED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_drift_checked",
+				Description: "The date and time when the drift detection was executed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeDriftChecked.Time"),
+			},
+			{
+				Name:        "drifted_properties",
+				Description: "The names of the properties whose actual value differs from the expected value.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(resourceManagerDriftedProperties),
+			},
+			{
+				Name:        "expected_properties",
+				Description: "The resource properties as defined in the stack, with values as JSON encoded strings.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "actual_properties",
+				Description: "The actual resource properties, with values as JSON encoded strings.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceName"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("StackId").Transform(ociRegionName),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listResourceManagerStackResourceDrifts(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	stack := h.Item.(resourcemanager.StackSummary)
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given stack_id doesn't match
+	if equalQuals["stack_id"] != nil && types.SafeString(stack.Id) != equalQuals["stack_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := resourceManagerService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_resourcemanager_stack_resource_drift.listResourceManagerStackResourceDrifts", "connection_error", err)
+		return nil, err
+	}
+
+	request := resourcemanager.ListStackResourceDriftDetailsRequest{
+		StackId: stack.Id,
+		Limit:   types.Int(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	if equalQuals["resource_drift_status"] != nil {
+		status, ok := resourcemanager.GetMappingStackResourceDriftSummaryResourceDriftStatusEnum(equalQuals["resource_drift_status"].GetStringValue())
+		if !ok {
+			return nil, nil
+		}
+		request.ResourceDriftStatus = []resourcemanager.StackResourceDriftSummaryResourceDriftStatusEnum{status}
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.ResourceManagerClient.ListStackResourceDriftDetails(ctx, request)
+		if err != nil {
+			// Stacks on which drift detection has never run have no report
+			if ociErr, ok := err.(common.ServiceError); ok && ociErr.GetHTTPStatusCode() == 404 {
+				return nil, nil
+			}
+			logger.Error("oci_resourcemanager_stack_resource_drift.listResourceManagerStackResourceDrifts", "api_error", err)
+			return nil, err
+		}
+
+		for _, drift := range response.Items {
+			d.StreamLeafListItem(ctx, drift)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTION
+
+func resourceManagerDriftedProperties(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	drift := d.HydrateItem.(resourcemanager.StackResourceDriftSummary)
+	if drift.ExpectedProperties == nil && drift.ActualProperties == nil {
+		return nil, nil
+	}
+
+	names := map[string]bool{}
+	for name, expected := range drift.ExpectedProperties {
+		if actual, ok := drift.ActualProperties[name]; !ok || actual != expected {
+			names[name] = true
+		}
+	}
+	for name := range drift.ActualProperties {
+		if _, ok := drift.ExpectedProperties[name]; !ok {
+			names[name] = true
+		}
+	}
+
+	drifted := []string{}
+	for name := range names {
+		drifted = append(drifted, name)
+	}
+	sort.Strings(drifted)
+
+	return drifted, nil
+}
@@ -0,0 +1,233 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/resourcemanager"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableResourceManagerStackTfResource(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_resourcemanager_stack_tf_resource",
+		Description:      "OCI Resource Manager Stack Terraform Resource",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			ParentHydrate: listResourceManagerStacks,
+			Hydrate:       listResourceManagerStackTfResources,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "stack_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "type",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "stack_id",
+				Description: "The OCID of the stack that manages the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "stack_name",
+				Description: "The display name of the stack that manages the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "address",
+				Description: "The address of the resource instance in the Terraform configuration, e.g. module.app.oci_core_instance.web[0].",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Address").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "type",
+				Description: "The Terraform resource type, e.g. oci_core_instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Type").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the resource in the Terraform configuration.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "module",
+				Description: "The address of the module that contains the resource, if not the root module.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "index_key",
+				Description: "The count index or for_each key of the resource instance, if any.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provider",
+				Description: "The provider of the resource, e.g. registry.terraform.io/oracle/oci.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Provider").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "provider_alias",
+				Description: "The alias of the provider configuration used by the resource, if any.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The id of the resource, which for OCI resources is usually the OCID.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_compartment_id",
+				Description: "The OCID of the compartment of the resource, from the compartment_id attribute.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "terraform_version",
+				Description: "The version of Terraform that wrote the state.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TerraformVersion").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "is_parsed",
+				Description: "True if the state of the stack was parsed successfully. A stack whose state could not be parsed has a single row without a resource.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "parse_error",
+				Description: "The reason the state of the stack could not be parsed.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Address").Transform(transform.NullIfZeroValue),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("StackId").Transform(ociRegionName),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+type resourceManagerStackTfResourceInfo struct {
+	terraformStateResource
+	ResourceCompartmentId *string
+	TerraformVersion      string
+	IsParsed              bool
+	ParseError            *string
+	StackId               *string
+	StackName             *string
+	CompartmentId         *string
+}
+
+//// LIST FUNCTION
+
+func listResourceManagerStackTfResources(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	stack := h.Item.(resourcemanager.StackSummary)
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given stack_id doesn't match
+	if equalQuals["stack_id"] != nil && types.SafeString(stack.Id) != equalQuals["stack_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := resourceManagerService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_resourcemanager_stack_tf_resource.listResourceManagerStackTfResources", "connection_error", err)
+		return nil, err
+	}
+
+	request := resourcemanager.GetStackTfStateRequest{
+		StackId: stack.Id,
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.ResourceManagerClient.GetStackTfState(ctx, request)
+	if err != nil {
+		// Stacks that have never been applied have no state
+		if ociErr, ok := err.(common.ServiceError); ok && ociErr.GetHTTPStatusCode() == 404 {
+			return nil, nil
+		}
+		logger.Error("oci_resourcemanager_stack_tf_resource.listResourceManagerStackTfResources", "api_error", err)
+		return nil, err
+	}
+	defer response.Content.Close()
+
+	// A state file that can't be parsed shouldn't fail the rows of other
+	// stacks, but is reported so that its resources aren't taken as unmanaged
+	state, err := parseTerraformState(response.Content)
+	if err != nil {
+		logger.Warn("oci_resourcemanager_stack_tf_resource.listResourceManagerStackTfResources", "parse_error", err, "stack_id", types.SafeString(stack.Id))
+		d.StreamLeafListItem(ctx, resourceManagerStackTfResourceInfo{
+			ParseError:    types.String(err.Error()),
+			StackId:       stack.Id,
+			StackName:     stack.DisplayName,
+			CompartmentId: stack.CompartmentId,
+		})
+		return nil, nil
+	}
+
+	for _, resource := range state.Resources {
+		if equalQuals["type"] != nil && resource.Type != equalQuals["type"].GetStringValue() {
+			continue
+		}
+
+		d.StreamLeafListItem(ctx, resourceManagerStackTfResourceInfo{
+			terraformStateResource: resource,
+			ResourceCompartmentId:  resource.CompartmentId,
+			TerraformVersion:       state.TerraformVersion,
+			IsParsed:               true,
+			StackId:                stack.Id,
+			StackName:              stack.DisplayName,
+			CompartmentId:          stack.CompartmentId,
+		})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,417 @@
+package oci
+
+import (
+	"context"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/cloudguard"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableSecurityZone(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_security_zone",
+		Description:      "OCI Security Zone",
+		DefaultTransform: transform.FromCamel(),
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getSecurityZone,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listSecurityZones,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "display_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "lifecycle_state",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "security_zone_recipe_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartmentList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "display_name",
+				Description: "The display name of the security zone.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The OCID of the security zone.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "security_zone_recipe_id",
+				Description: "The OCID of the recipe of the security zone.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "security_zone_target_id",
+				Description: "The OCID of the Cloud Guard target of the security zone.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSecurityZone,
+			},
+			{
+				Name:        "lifecycle_state",
+				Description: "The current state of the security zone.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_details",
+				Description: "A message describing the current state in more detail.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the security zone.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_created",
+				Description: "The date and time the security zone was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeCreated.Time"),
+			},
+			{
+				Name:        "time_updated",
+				Description: "The date and time the security zone was updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeUpdated.Time"),
+			},
+
+			// json fields
+			{
+				Name:        "inherited_by_compartments",
+				Description: "The OCIDs of the subcompartments that inherit the security zone.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSecurityZone,
+			},
+
+			// tags
+			{
+				Name:        "defined_tags",
+				Description: ColumnDescriptionDefinedTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "freeform_tags",
+				Description: ColumnDescriptionFreefromTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "system_tags",
+				Description: ColumnDescriptionSystemTags,
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(securityZoneTags),
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "compartment_id",
+				Description: "The OCID of the compartment protected by the security zone.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listSecurityZones(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Debug("oci.listSecurityZones", "Compartment", compartment)
+
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given compartment_id doesn't match
+	if equalQuals["compartment_id"] != nil && compartment != equalQuals["compartment_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// fetch reporting region from configuration
+	getCloudGuardConfigurationCached := plugin.HydrateFunc(getCloudGuardConfiguration).WithCache()
+	configuration, err := getCloudGuardConfigurationCached(ctx, d, h)
+	if err != nil {
+		logger.Error("oci_security_zone.listSecurityZones", "configuration_error", err)
+		return nil, err
+	}
+
+	reportingRegion := configuration.(cloudguard.Configuration).ReportingRegion
+
+	// Create Session
+	session, err := cloudGuardService(ctx, d, *reportingRegion)
+	if err != nil {
+		logger.Error("oci_security_zone.listSecurityZones", "connection_error", err)
+		return nil, err
+	}
+
+	// Build request parameters
+	request := buildSecurityZoneFilters(equalQuals)
+	request.CompartmentId = types.String(compartment)
+	request.Limit = types.Int(1000)
+	request.RequestMetadata = common.RequestMetadata{
+		RetryPolicy: getDefaultRetryPolicy(d.Connection),
+	}
+
+	// Check for limit
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < int64(*request.Limit) {
+			request.Limit = types.Int(int(*limit))
+		}
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.CloudGuardClient.ListSecurityZones(ctx, request)
+		if err != nil {
+			logger.Error("oci_security_zone.listSecurityZones", "api_error", err)
+			return nil, err
+		}
+		for _, zone := range response.Items {
+			d.StreamListItem(ctx, zone)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTION
+
+func getSecurityZone(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Debug("oci.getSecurityZone", "Compartment", compartment)
+
+	var id string
+	if h.Item != nil {
+		id = *h.Item.(cloudguard.SecurityZoneSummary).Id
+	} else {
+		// Restrict the api call to only root compartment
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+			return nil, nil
+		}
+		id = d.KeyColumnQuals["id"].GetStringValue()
+	}
+
+	// handle empty id in get call
+	if id == "" {
+		return nil, nil
+	}
+
+	// fetch reporting region from configuration
+	getCloudGuardConfigurationCached := plugin.HydrateFunc(getCloudGuardConfiguration).WithCache()
+	configuration, err := getCloudGuardConfigurationCached(ctx, d, h)
+	if err != nil {
+		logger.Error("oci_security_zone.getSecurityZone", "configuration_error", err)
+		return nil, err
+	}
+
+	reportingRegion := configuration.(cloudguard.Configuration).ReportingRegion
+
+	// Create Session
+	session, err := cloudGuardService(ctx, d, *reportingRegion)
+	if err != nil {
+		logger.Error("oci_security_zone.getSecurityZone", "connection_error", err)
+		return nil, err
+	}
+
+	request := cloudguard.GetSecurityZoneRequest{
+		SecurityZoneId: types.String(id),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.CloudGuardClient.GetSecurityZone(ctx, request)
+	if err != nil {
+		logger.Error("oci_security_zone.getSecurityZone", "api_error", err)
+		return nil, err
+	}
+
+	return response.SecurityZone, nil
+}
+
+// getSecurityZoneIdsByCompartment maps each compartment that is directly
+// associated with a security zone to the zone's OCID. The map is cached for the
+// connection.
+func getSecurityZoneIdsByCompartment(ctx context.Context, d *plugin.QueryData) (map[string]string, error) {
+	cacheKey := "getSecurityZoneIdsByCompartment"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(map[string]string), nil
+	}
+
+	zones := map[string]string{}
+
+	configuration, err := getCloudGuardConfiguration(ctx, d, nil)
+	if err != nil {
+		// Security zones require Cloud Guard, which may not be enabled
+		if ociErr, ok := err.(common.ServiceError); ok && ociErr.GetHTTPStatusCode() == 404 {
+			d.ConnectionManager.Cache.Set(cacheKey, zones)
+			return zones, nil
+		}
+		return nil, err
+	}
+
+	reportingRegion := configuration.(cloudguard.Configuration).ReportingRegion
+	if reportingRegion == nil {
+		d.ConnectionManager.Cache.Set(cacheKey, zones)
+		return zones, nil
+	}
+
+	// Create Session
+	session, err := cloudGuardService(ctx, d, *reportingRegion)
+	if err != nil {
+		return nil, err
+	}
+
+	request := cloudguard.ListSecurityZonesRequest{
+		CompartmentId:                    types.String(session.TenancyID),
+		IsRequiredSecurityZonesInSubtree: types.Bool(true),
+		LifecycleState:                   cloudguard.ListSecurityZonesLifecycleStateActive,
+		Limit:                            types.Int(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.CloudGuardClient.ListSecurityZones(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		for _, zone := range response.Items {
+			zones[types.SafeString(zone.CompartmentId)] = types.SafeString(zone.Id)
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	d.ConnectionManager.Cache.Set(cacheKey, zones)
+
+	return zones, nil
+}
+
+//// TRANSFORM FUNCTION
+
+// Priority order for tags
+// 1. System Tags
+// 2. Defined Tags
+// 3. Free-form tags
+func securityZoneTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	var freeformTags map[string]string
+	var definedTags map[string]map[string]interface{}
+	var systemTags map[string]map[string]interface{}
+
+	switch zone := d.HydrateItem.(type) {
+	case cloudguard.SecurityZoneSummary:
+		freeformTags = zone.FreeformTags
+		definedTags = zone.DefinedTags
+		systemTags = zone.SystemTags
+	case cloudguard.SecurityZone:
+		freeformTags = zone.FreeformTags
+		definedTags = zone.DefinedTags
+		systemTags = zone.SystemTags
+	}
+
+	var tags map[string]interface{}
+
+	if freeformTags != nil {
+		tags = map[string]interface{}{}
+		for k, v := range freeformTags {
+			tags[k] = v
+		}
+	}
+
+	if definedTags != nil {
+		if tags == nil {
+			tags = map[string]interface{}{}
+		}
+		for _, v := range definedTags {
+			for key, value := range v {
+				tags[key] = value
+			}
+		}
+	}
+
+	if systemTags != nil {
+		if tags == nil {
+			tags = map[string]interface{}{}
+		}
+		for _, v := range systemTags {
+			for key, value := range v {
+				tags[key] = value
+			}
+		}
+	}
+
+	return tags, nil
+}
+
+// Build additional filters
+func buildSecurityZoneFilters(equalQuals plugin.KeyColumnEqualsQualMap) cloudguard.ListSecurityZonesRequest {
+	request := cloudguard.ListSecurityZonesRequest{}
+
+	if equalQuals["display_name"] != nil {
+		request.DisplayName = types.String(equalQuals["display_name"].GetStringValue())
+	}
+	if equalQuals["lifecycle_state"] != nil {
+		request.LifecycleState = cloudguard.ListSecurityZonesLifecycleStateEnum(equalQuals["lifecycle_state"].GetStringValue())
+	}
+	if equalQuals["security_zone_recipe_id"] != nil {
+		request.SecurityRecipeId = types.String(equalQuals["security_zone_recipe_id"].GetStringValue())
+	}
+
+	return request
+}
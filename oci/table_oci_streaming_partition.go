@@ -0,0 +1,421 @@
+package oci
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/streaming"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+const (
+	// the maximum number of messages read per GetMessages call when measuring lag
+	streamPartitionLagBatchSize = 100
+	// the default maximum number of messages read per partition when the lag
+	// can't be computed from offsets, overridden by stream_partition_lag_max_messages
+	streamPartitionLagDefaultMaxMessages = 100
+)
+
+//// TABLE DEFINITION
+
+func tableStreamingPartition(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_streaming_partition",
+		Description:      "OCI Streaming Partition",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			Hydrate: listStreamingPartitions,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "stream_id",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "group_name",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "partition",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "stream_id",
+				Description: "The OCID of the stream.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "stream_name",
+				Description: "The name of the stream.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "group_name",
+				Description: "The name of the consumer group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "partition",
+				Description: "The partition of the stream.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "committed_offset",
+				Description: "The last offset committed by the consumer group on the partition. Null if the group hasn't committed on the partition yet.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "latest_offset",
+				Description: "The offset of the latest message in the partition. Null if the group hasn't committed on the partition yet or lag measurement is disabled.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "lag",
+				Description: "The number of messages in the partition after the committed offset, i.e. not yet consumed by the group. Null if the group hasn't committed on the partition yet or lag measurement is disabled.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "is_lag_truncated",
+				Description: "True if the offset of the latest message of the partition couldn't be determined, in which case lag and latest_offset are lower bounds counted from at most stream_partition_lag_max_messages messages read after the committed offset.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "reserved_instance",
+				Description: "The consumer instance that holds the reservation of the partition.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_reserved_until",
+				Description: "The date and time the reservation of the partition expires.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeReservedUntil.Time"),
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(streamingPartitionTitle),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+// streamingPartitionInfo is the position of a consumer group on a partition of
+// a stream
+type streamingPartitionInfo struct {
+	StreamId          *string
+	StreamName        *string
+	GroupName         string
+	Partition         string
+	CommittedOffset   *int64
+	LatestOffset      *int64
+	Lag               *int64
+	IsLagTruncated    bool
+	ReservedInstance  *string
+	TimeReservedUntil *common.SDKTime
+	Region            string
+	CompartmentId     *string
+}
+
+//// LIST FUNCTION
+
+func listStreamingPartitions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	logger.Trace("listStreamingPartitions", "OCI_REGION", region)
+
+	streamId := d.KeyColumnQuals["stream_id"].GetStringValue()
+	groupName := d.KeyColumnQuals["group_name"].GetStringValue()
+
+	// Streams are only served by the region in their OCID
+	parts := strings.Split(streamId, ".")
+	if len(parts) < 4 || string(common.StringToRegion(parts[3])) != region {
+		return nil, nil
+	}
+
+	// Create Session
+	adminSession, err := streamAdminService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_streaming_partition.listStreamingPartitions", "connection_error", err)
+		return nil, err
+	}
+
+	// The messages endpoint is only returned by the get call
+	streamResponse, err := adminSession.StreamAdminClient.GetStream(ctx, streaming.GetStreamRequest{
+		StreamId: types.String(streamId),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	})
+	if err != nil {
+		if ociErr, ok := err.(common.ServiceError); ok && ociErr.GetHTTPStatusCode() == 404 {
+			return nil, nil
+		}
+		logger.Error("oci_streaming_partition.listStreamingPartitions", "api_error", err)
+		return nil, err
+	}
+	stream := streamResponse.Stream
+
+	session, err := streamService(ctx, d, region, types.SafeString(stream.MessagesEndpoint))
+	if err != nil {
+		logger.Error("oci_streaming_partition.listStreamingPartitions", "connection_error", err)
+		return nil, err
+	}
+
+	groupResponse, err := session.StreamClient.GetGroup(ctx, streaming.GetGroupRequest{
+		StreamId:  types.String(streamId),
+		GroupName: types.String(groupName),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	})
+	if err != nil {
+		if ociErr, ok := err.(common.ServiceError); ok && ociErr.GetHTTPStatusCode() == 404 {
+			return nil, nil
+		}
+		logger.Error("oci_streaming_partition.listStreamingPartitions", "api_error", err)
+		return nil, err
+	}
+
+	reservations := map[string]streaming.PartitionReservation{}
+	for _, reservation := range groupResponse.Reservations {
+		reservations[types.SafeString(reservation.Partition)] = reservation
+	}
+
+	maxMessages := streamPartitionLagDefaultMaxMessages
+	ociConfig := GetConfig(d.Connection)
+	if ociConfig.StreamPartitionLagMaxMessages != nil {
+		maxMessages = *ociConfig.StreamPartitionLagMaxMessages
+	}
+
+	for i := 0; i < types.IntValue(stream.Partitions); i++ {
+		partition := strconv.Itoa(i)
+		if d.KeyColumnQuals["partition"] != nil && partition != d.KeyColumnQuals["partition"].GetStringValue() {
+			continue
+		}
+
+		info := streamingPartitionInfo{
+			StreamId:      stream.Id,
+			StreamName:    stream.Name,
+			GroupName:     groupName,
+			Partition:     partition,
+			Region:        region,
+			CompartmentId: stream.CompartmentId,
+		}
+		if reservation, ok := reservations[partition]; ok {
+			info.CommittedOffset = reservation.CommittedOffset
+			info.ReservedInstance = reservation.ReservedInstance
+			info.TimeReservedUntil = reservation.TimeReservedUntil
+		}
+
+		err := measureStreamingPartitionLag(ctx, d, session, &info, maxMessages)
+		if err != nil {
+			logger.Error("oci_streaming_partition.listStreamingPartitions", "api_error", err)
+			return nil, err
+		}
+
+		d.StreamListItem(ctx, info)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// measureStreamingPartitionLag computes the lag of the group on the partition
+// from the committed offset and the offset of the latest message. The stream
+// API has no call returning the latest offset of a partition, so it is taken
+// from the position of a LATEST cursor. If that position can't be read, at
+// most maxMessages messages after the committed offset are read instead.
+// Partitions the group hasn't committed on are skipped, as their lag would be
+// the whole retained partition.
+func measureStreamingPartitionLag(ctx context.Context, d *plugin.QueryData, session *session, info *streamingPartitionInfo, maxMessages int) error {
+	if info.CommittedOffset == nil || maxMessages <= 0 {
+		return nil
+	}
+
+	headOffset, ok, err := getStreamingPartitionHeadOffset(ctx, d, session, info)
+	if err != nil {
+		return err
+	}
+
+	lag := int64(0)
+	latestOffset := *info.CommittedOffset
+	info.Lag = &lag
+	info.LatestOffset = &latestOffset
+
+	if ok {
+		if headOffset > latestOffset {
+			latestOffset = headOffset
+			lag = headOffset - *info.CommittedOffset
+		}
+		return nil
+	}
+
+	// The lag is a lower bound unless the messages read reach the head of the
+	// partition, which is unknown here
+	info.IsLagTruncated = true
+
+	cursorResponse, err := session.StreamClient.CreateCursor(ctx, streaming.CreateCursorRequest{
+		StreamId: info.StreamId,
+		CreateCursorDetails: streaming.CreateCursorDetails{
+			Partition: types.String(info.Partition),
+			Type:      streaming.CreateCursorDetailsTypeAfterOffset,
+			Offset:    info.CommittedOffset,
+		},
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// Empty batches don't mark the end of the partition, but each of them uses
+	// up a batch of the budget so reading always stops
+	cursor := cursorResponse.Value
+	for remaining := maxMessages; remaining > 0; {
+		limit := streamPartitionLagBatchSize
+		if remaining < limit {
+			limit = remaining
+		}
+
+		response, err := session.StreamClient.GetMessages(ctx, streaming.GetMessagesRequest{
+			StreamId: info.StreamId,
+			Cursor:   cursor,
+			Limit:    types.Int(limit),
+			RequestMetadata: common.RequestMetadata{
+				RetryPolicy: getDefaultRetryPolicy(d.Connection),
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(response.Items) == 0 {
+			remaining -= limit
+		} else {
+			lag += int64(len(response.Items))
+			latestOffset = types.Int64Value(response.Items[len(response.Items)-1].Offset)
+			remaining -= len(response.Items)
+		}
+		cursor = response.OpcNextCursor
+	}
+
+	return nil
+}
+
+// getStreamingPartitionHeadOffset returns the offset of the latest message of
+// the partition, read from the position of a LATEST cursor. If the cursor
+// isn't resolved to an offset when it is created, it is resolved by reading
+// from it. False is returned if the offset can't be read from the cursors.
+func getStreamingPartitionHeadOffset(ctx context.Context, d *plugin.QueryData, session *session, info *streamingPartitionInfo) (int64, bool, error) {
+	cursorResponse, err := session.StreamClient.CreateCursor(ctx, streaming.CreateCursorRequest{
+		StreamId: info.StreamId,
+		CreateCursorDetails: streaming.CreateCursorDetails{
+			Partition: types.String(info.Partition),
+			Type:      streaming.CreateCursorDetailsTypeLatest,
+		},
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	})
+	if err != nil {
+		return 0, false, err
+	}
+	if offset, ok := streamCursorLatestOffset(cursorResponse.Value); ok {
+		return offset, true, nil
+	}
+
+	response, err := session.StreamClient.GetMessages(ctx, streaming.GetMessagesRequest{
+		StreamId: info.StreamId,
+		Cursor:   cursorResponse.Value,
+		Limit:    types.Int(1),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	})
+	if err != nil {
+		return 0, false, err
+	}
+	// A message produced since the cursor was created is the latest one
+	if len(response.Items) > 0 && response.Items[0].Offset != nil {
+		return *response.Items[0].Offset, true, nil
+	}
+	offset, ok := streamCursorLatestOffset(response.OpcNextCursor)
+	return offset, ok, nil
+}
+
+// streamCursorLatestOffset returns the offset of the last message before the
+// position of a cursor. Cursors are base64 encoded JSON documents with the
+// type and offset of the position; false is returned if the cursor can't be
+// decoded or isn't positioned at or after an offset.
+func streamCursorLatestOffset(cursor *string) (int64, bool) {
+	var data []byte
+	var err error
+	for _, encoding := range []*base64.Encoding{base64.StdEncoding, base64.URLEncoding, base64.RawStdEncoding, base64.RawURLEncoding} {
+		if data, err = encoding.DecodeString(types.SafeString(cursor)); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return 0, false
+	}
+
+	var position struct {
+		Type   string `json:"type"`
+		Offset *int64 `json:"offset"`
+	}
+	if err := json.Unmarshal(data, &position); err != nil || position.Offset == nil {
+		return 0, false
+	}
+
+	switch strings.ToUpper(strings.ReplaceAll(position.Type, "_", "")) {
+	case "AFTEROFFSET":
+		return *position.Offset, true
+	case "ATOFFSET":
+		return *position.Offset - 1, true
+	}
+	return 0, false
+}
+
+//// TRANSFORM FUNCTION
+
+func streamingPartitionTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	info := d.HydrateItem.(streamingPartitionInfo)
+	return types.SafeString(info.StreamName) + "/" + info.Partition + "/" + info.GroupName, nil
+}
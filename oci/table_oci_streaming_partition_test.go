@@ -0,0 +1,66 @@
+package oci
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/turbot/go-kit/types"
+)
+
+func TestStreamCursorLatestOffset(t *testing.T) {
+	encode := func(position string) *string {
+		return types.String(base64.StdEncoding.EncodeToString([]byte(position)))
+	}
+
+	tests := []struct {
+		name           string
+		cursor         *string
+		expectedOffset int64
+		expectedOk     bool
+	}{
+		{
+			name:           "after offset",
+			cursor:         encode(`{"cursorType":"partition","type":"AfterOffset","offset":41,"partition":"0"}`),
+			expectedOffset: 41,
+			expectedOk:     true,
+		},
+		{
+			name:           "at offset",
+			cursor:         encode(`{"cursorType":"partition","type":"AtOffset","offset":42,"partition":"0"}`),
+			expectedOffset: 41,
+			expectedOk:     true,
+		},
+		{
+			name:           "url encoding without padding",
+			cursor:         types.String(base64.RawURLEncoding.EncodeToString([]byte(`{"type":"AFTER_OFFSET","offset":7}`))),
+			expectedOffset: 7,
+			expectedOk:     true,
+		},
+		{
+			name:   "unresolved latest cursor",
+			cursor: encode(`{"cursorType":"partition","type":"Latest","offset":null,"partition":"0"}`),
+		},
+		{
+			name:   "time cursor",
+			cursor: encode(`{"type":"AtTime","offset":42}`),
+		},
+		{
+			name:   "not base64",
+			cursor: types.String("not a cursor!"),
+		},
+		{
+			name:   "not json",
+			cursor: encode("cursor"),
+		},
+		{
+			name: "no cursor",
+		},
+	}
+
+	for _, test := range tests {
+		offset, ok := streamCursorLatestOffset(test.cursor)
+		if ok != test.expectedOk || offset != test.expectedOffset {
+			t.Errorf("%s: expected %d %t, got %d %t", test.name, test.expectedOffset, test.expectedOk, offset, ok)
+		}
+	}
+}
@@ -0,0 +1,328 @@
+package oci
+
+import (
+	"context"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/streaming"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableStreamingStreamPool(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_streaming_stream_pool",
+		Description:      "OCI Streaming Stream Pool",
+		DefaultTransform: transform.FromCamel(),
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getStreamingStreamPool,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listStreamingStreamPools,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "lifecycle_state",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the stream pool.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The OCID of the stream pool.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_state",
+				Description: "The current state of the stream pool.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_state_details",
+				Description: "Any additional details about the current state of the stream pool.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStreamingStreamPool,
+			},
+			{
+				Name:        "time_created",
+				Description: "The date and time the stream pool was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeCreated.Time"),
+			},
+			{
+				Name:        "is_private",
+				Description: "True if the stream pool is only reachable through a private endpoint.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "endpoint_fqdn",
+				Description: "The FQDN of the private endpoint of the stream pool, or the public endpoint.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStreamingStreamPool,
+			},
+			{
+				Name:        "kafka_bootstrap_servers",
+				Description: "The bootstrap servers for Kafka clients of the stream pool.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStreamingStreamPool,
+				Transform:   transform.FromField("KafkaSettings.BootstrapServers"),
+			},
+			{
+				Name:        "kms_key_id",
+				Description: "The OCID of the customer-managed key used to encrypt the stream pool. Null if the pool is encrypted with an Oracle-managed key.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStreamingStreamPool,
+				Transform:   transform.FromField("CustomEncryptionKey.KmsKeyId"),
+			},
+
+			// json fields
+			{
+				Name:        "kafka_settings",
+				Description: "The Kafka compatibility settings of the stream pool, e.g. the default number of partitions and retention of auto-created topics.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getStreamingStreamPool,
+			},
+			{
+				Name:        "private_endpoint_settings",
+				Description: "The subnet, IP address and network security groups of the private endpoint of the stream pool.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getStreamingStreamPool,
+			},
+			{
+				Name:        "custom_encryption_key",
+				Description: "The customer-managed key used to encrypt the stream pool and its state.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getStreamingStreamPool,
+			},
+
+			// tags
+			{
+				Name:        "defined_tags",
+				Description: ColumnDescriptionDefinedTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "freeform_tags",
+				Description: ColumnDescriptionFreefromTags,
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(streamPoolTags),
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id").Transform(ociRegionName),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listStreamingStreamPools(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Trace("listStreamingStreamPools", "Compartment", compartment, "OCI_REGION", region)
+
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given compartment_id doesn't match
+	if equalQuals["compartment_id"] != nil && compartment != equalQuals["compartment_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := streamAdminService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_streaming_stream_pool.listStreamingStreamPools", "connection_error", err)
+		return nil, err
+	}
+
+	// Build request parameters
+	request := buildStreamingStreamPoolFilters(equalQuals)
+	request.CompartmentId = types.String(compartment)
+	request.Limit = types.Int(50)
+	request.RequestMetadata = common.RequestMetadata{
+		RetryPolicy: getDefaultRetryPolicy(d.Connection),
+	}
+
+	// Check for limit
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < int64(*request.Limit) {
+			request.Limit = types.Int(int(*limit))
+		}
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.StreamAdminClient.ListStreamPools(ctx, request)
+		if err != nil {
+			logger.Error("oci_streaming_stream_pool.listStreamingStreamPools", "api_error", err)
+			return nil, err
+		}
+
+		for _, pool := range response.Items {
+			d.StreamListItem(ctx, pool)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTION
+
+func getStreamingStreamPool(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Trace("getStreamingStreamPool", "Compartment", compartment, "OCI_REGION", region)
+
+	var id string
+	if h.Item != nil {
+		id = *h.Item.(streaming.StreamPoolSummary).Id
+	} else {
+		id = d.KeyColumnQuals["id"].GetStringValue()
+		// Restrict the api call to only root compartment/ per region
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+			return nil, nil
+		}
+	}
+
+	// handle empty id in get call
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := streamAdminService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_streaming_stream_pool.getStreamingStreamPool", "connection_error", err)
+		return nil, err
+	}
+
+	request := streaming.GetStreamPoolRequest{
+		StreamPoolId: types.String(id),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.StreamAdminClient.GetStreamPool(ctx, request)
+	if err != nil {
+		logger.Error("oci_streaming_stream_pool.getStreamingStreamPool", "api_error", err)
+		return nil, err
+	}
+
+	return response.StreamPool, nil
+}
+
+//// TRANSFORM FUNCTION
+
+func streamPoolTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	var freeformTags map[string]string
+	var definedTags map[string]map[string]interface{}
+
+	switch pool := d.HydrateItem.(type) {
+	case streaming.StreamPoolSummary:
+		freeformTags = pool.FreeformTags
+		definedTags = pool.DefinedTags
+	case streaming.StreamPool:
+		freeformTags = pool.FreeformTags
+		definedTags = pool.DefinedTags
+	}
+
+	var tags map[string]interface{}
+
+	if freeformTags != nil {
+		tags = map[string]interface{}{}
+		for k, v := range freeformTags {
+			tags[k] = v
+		}
+	}
+
+	if definedTags != nil {
+		if tags == nil {
+			tags = map[string]interface{}{}
+		}
+		for _, v := range definedTags {
+			for key, value := range v {
+				tags[key] = value
+			}
+		}
+	}
+
+	return tags, nil
+}
+
+// Build additional filters
+func buildStreamingStreamPoolFilters(equalQuals plugin.KeyColumnEqualsQualMap) streaming.ListStreamPoolsRequest {
+	request := streaming.ListStreamPoolsRequest{}
+
+	if equalQuals["name"] != nil {
+		request.Name = types.String(equalQuals["name"].GetStringValue())
+	}
+	if equalQuals["lifecycle_state"] != nil {
+		request.LifecycleState = streaming.StreamPoolSummaryLifecycleStateEnum(equalQuals["lifecycle_state"].GetStringValue())
+	}
+
+	return request
+}
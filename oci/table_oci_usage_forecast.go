@@ -0,0 +1,103 @@
+package oci
+
+import (
+	"context"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/usageapi"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableUsageForecast(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_usage_forecast",
+		Description:      "OCI Usage Forecast",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			Hydrate: listUsageForecasts,
+			KeyColumns: append(usageSummaryKeyColumns(), &plugin.KeyColumn{
+				Name:    "time_forecast_ended",
+				Require: plugin.Optional,
+			}),
+		},
+		Columns: append(usageSummaryColumns(), &plugin.Column{
+			Name:        "time_forecast_ended",
+			Description: "The end time of the forecast. Defaults to 30 days from now.",
+			Type:        proto.ColumnType_TIMESTAMP,
+		}),
+	}
+}
+
+type usageForecastInfo struct {
+	usageSummaryInfo
+	TimeForecastEnded time.Time
+}
+
+//// LIST FUNCTION
+
+func listUsageForecasts(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	// The Usage API is served from the home region
+	region, err := getHomeRegion(ctx, d)
+	if err != nil {
+		logger.Error("oci_usage_forecast.listUsageForecasts", "api_error", err)
+		return nil, err
+	}
+
+	// Create Session
+	session, err := usageApiService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_usage_forecast.listUsageForecasts", "connection_error", err)
+		return nil, err
+	}
+
+	request, info, err := buildUsageSummaryRequest(d, session.TenancyID)
+	if err != nil {
+		logger.Error("oci_usage_forecast.listUsageForecasts", "invalid_quals", err)
+		return nil, err
+	}
+
+	// The forecast continues from the end of the usage time range
+	now := time.Now().UTC()
+	forecastEnded := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, 30)
+	if d.KeyColumnQuals["time_forecast_ended"] != nil {
+		forecastEnded = d.KeyColumnQuals["time_forecast_ended"].GetTimestampValue().AsTime().UTC()
+	}
+	request.Forecast = &usageapi.Forecast{
+		ForecastType:      usageapi.ForecastForecastTypeBasic,
+		TimeForecastEnded: &common.SDKTime{Time: forecastEnded},
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.UsageapiClient.RequestSummarizedUsages(ctx, request)
+		if err != nil {
+			logger.Error("oci_usage_forecast.listUsageForecasts", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range response.Items {
+			row := usageForecastInfo{info, forecastEnded}
+			row.UsageSummary = item
+			d.StreamListItem(ctx, row)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
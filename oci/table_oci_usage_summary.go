@@ -0,0 +1,464 @@
+package oci
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/usageapi"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableUsageSummary(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_usage_summary",
+		Description:      "OCI Usage Summary",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			Hydrate:    listUsageSummaries,
+			KeyColumns: usageSummaryKeyColumns(),
+		},
+		Columns: usageSummaryColumns(),
+	}
+}
+
+// usageSummaryDimensions are the dimensions the Usage API can group by
+var usageSummaryDimensions = []string{
+	"tagNamespace",
+	"tagKey",
+	"tagValue",
+	"service",
+	"skuName",
+	"skuPartNumber",
+	"unit",
+	"compartmentName",
+	"compartmentPath",
+	"compartmentId",
+	"platform",
+	"region",
+	"logicalAd",
+	"resourceId",
+	"tenantId",
+	"tenantName",
+}
+
+type usageSummaryInfo struct {
+	usageapi.UsageSummary
+	Granularity string
+	QueryType   string
+	GroupBy     string
+	GroupByTag  string
+}
+
+func usageSummaryKeyColumns() []*plugin.KeyColumn {
+	return []*plugin.KeyColumn{
+		{
+			Name:      "time_usage_started",
+			Require:   plugin.Optional,
+			Operators: []string{">", ">=", "="},
+		},
+		{
+			Name:      "time_usage_ended",
+			Require:   plugin.Optional,
+			Operators: []string{"<", "<=", "="},
+		},
+		{
+			Name:    "granularity",
+			Require: plugin.Optional,
+		},
+		{
+			Name:    "query_type",
+			Require: plugin.Optional,
+		},
+		{
+			Name:    "group_by",
+			Require: plugin.Optional,
+		},
+		{
+			Name:    "group_by_tag",
+			Require: plugin.Optional,
+		},
+	}
+}
+
+func usageSummaryColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "time_usage_started",
+			Description: "The usage start time.",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Transform:   transform.FromField("TimeUsageStarted.Time"),
+		},
+		{
+			Name:        "time_usage_ended",
+			Description: "The usage end time.",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Transform:   transform.FromField("TimeUsageEnded.Time"),
+		},
+		{
+			Name:        "granularity",
+			Description: "The usage granularity: HOURLY, DAILY, MONTHLY or TOTAL. Defaults to DAILY.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "query_type",
+			Description: "The query type: USAGE, COST, CREDIT, EXPIREDCREDIT or ALLCREDIT. Defaults to COST.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "group_by",
+			Description: "A comma separated list of the dimensions the usage is grouped by, e.g. service,compartmentName. Defaults to service.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "group_by_tag",
+			Description: "A comma separated list of the tags the usage is grouped by, each given as namespace.key.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "service",
+			Description: "The service name that is incurring the cost.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "compartment_name",
+			Description: "The compartment name.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "compartment_path",
+			Description: "The compartment path, starting from root.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "resource_id",
+			Description: "The resource OCID that is incurring the cost.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "resource_name",
+			Description: "The resource name that is incurring the cost.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "sku_part_number",
+			Description: "The SKU part number.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "sku_name",
+			Description: "The SKU friendly name.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "platform",
+			Description: "The platform for the cost.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "ad",
+			Description: "The availability domain of the resource.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "shape",
+			Description: "The resource shape.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "computed_amount",
+			Description: "The computed cost.",
+			Type:        proto.ColumnType_DOUBLE,
+		},
+		{
+			Name:        "computed_quantity",
+			Description: "The usage number.",
+			Type:        proto.ColumnType_DOUBLE,
+		},
+		{
+			Name:        "unit",
+			Description: "The usage unit.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "currency",
+			Description: "The price currency.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "unit_price",
+			Description: "The price per unit.",
+			Type:        proto.ColumnType_DOUBLE,
+		},
+		{
+			Name:        "list_rate",
+			Description: "The SKU list rate (not discount).",
+			Type:        proto.ColumnType_DOUBLE,
+		},
+		{
+			Name:        "discount",
+			Description: "The discretionary discount applied to the SKU.",
+			Type:        proto.ColumnType_DOUBLE,
+		},
+		{
+			Name:        "overage",
+			Description: "The overage usage.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "overages_flag",
+			Description: "The SPM overage flag.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "subscription_id",
+			Description: "The subscription ID.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "weight",
+			Description: "The resource size being metered.",
+			Type:        proto.ColumnType_DOUBLE,
+		},
+		{
+			Name:        "is_forecast",
+			Description: "True if the row is a forecasted value.",
+			Type:        proto.ColumnType_BOOL,
+		},
+		{
+			Name:        "tenant_name",
+			Description: "The tenancy name.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "usage_tags",
+			Description: "The tags the usage is grouped by.",
+			Type:        proto.ColumnType_JSON,
+			Transform:   transform.FromField("Tags"),
+		},
+
+		// Standard OCI columns
+		{
+			Name:        "region",
+			Description: "The region of the usage.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "compartment_id",
+			Description: ColumnDescriptionCompartment,
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "tenant_id",
+			Description: ColumnDescriptionTenant,
+			Type:        proto.ColumnType_STRING,
+			Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+			Transform:   transform.FromValue(),
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listUsageSummaries(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	// The Usage API is served from the home region
+	region, err := getHomeRegion(ctx, d)
+	if err != nil {
+		logger.Error("oci_usage_summary.listUsageSummaries", "api_error", err)
+		return nil, err
+	}
+
+	// Create Session
+	session, err := usageApiService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_usage_summary.listUsageSummaries", "connection_error", err)
+		return nil, err
+	}
+
+	request, info, err := buildUsageSummaryRequest(d, session.TenancyID)
+	if err != nil {
+		logger.Error("oci_usage_summary.listUsageSummaries", "invalid_quals", err)
+		return nil, err
+	}
+
+	return nil, streamUsageSummaries(ctx, d, session, request, info)
+}
+
+// buildUsageSummaryRequest builds the summarized usages request from the
+// query quals. Usage is requested for the last 30 days by default.
+func buildUsageSummaryRequest(d *plugin.QueryData, tenancyId string) (usageapi.RequestSummarizedUsagesRequest, usageSummaryInfo, error) {
+	equalQuals := d.KeyColumnQuals
+	info := usageSummaryInfo{
+		Granularity: string(usageapi.RequestSummarizedUsagesDetailsGranularityDaily),
+		QueryType:   string(usageapi.RequestSummarizedUsagesDetailsQueryTypeCost),
+		GroupBy:     "service",
+	}
+	request := usageapi.RequestSummarizedUsagesRequest{
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	if equalQuals["granularity"] != nil {
+		info.Granularity = equalQuals["granularity"].GetStringValue()
+	}
+	granularity, ok := usageapi.GetMappingRequestSummarizedUsagesDetailsGranularityEnum(info.Granularity)
+	if !ok {
+		return request, info, fmt.Errorf("unsupported granularity %q, supported values are %s", info.Granularity, strings.Join(usageapi.GetRequestSummarizedUsagesDetailsGranularityEnumStringValues(), ", "))
+	}
+
+	if equalQuals["query_type"] != nil {
+		info.QueryType = equalQuals["query_type"].GetStringValue()
+	}
+	queryType, ok := usageapi.GetMappingRequestSummarizedUsagesDetailsQueryTypeEnum(info.QueryType)
+	if !ok {
+		return request, info, fmt.Errorf("unsupported query_type %q, supported values are %s", info.QueryType, strings.Join(usageapi.GetRequestSummarizedUsagesDetailsQueryTypeEnumStringValues(), ", "))
+	}
+
+	if equalQuals["group_by"] != nil {
+		info.GroupBy = equalQuals["group_by"].GetStringValue()
+	}
+	var groupBy []string
+	for _, dimension := range strings.Split(info.GroupBy, ",") {
+		dimension = strings.TrimSpace(dimension)
+		if dimension == "" {
+			continue
+		}
+		found := false
+		for _, supported := range usageSummaryDimensions {
+			if strings.EqualFold(dimension, supported) {
+				groupBy = append(groupBy, supported)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return request, info, fmt.Errorf("unsupported group_by dimension %q, supported values are %s", dimension, strings.Join(usageSummaryDimensions, ", "))
+		}
+	}
+
+	var groupByTag []usageapi.Tag
+	if equalQuals["group_by_tag"] != nil {
+		info.GroupByTag = equalQuals["group_by_tag"].GetStringValue()
+		for _, tag := range strings.Split(info.GroupByTag, ",") {
+			parts := strings.SplitN(strings.TrimSpace(tag), ".", 2)
+			if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+				return request, info, fmt.Errorf("group_by_tag entries must be given as namespace.key, found %q", tag)
+			}
+			groupByTag = append(groupByTag, usageapi.Tag{Namespace: types.String(parts[0]), Key: types.String(parts[1])})
+		}
+	}
+
+	// The Usage API expects the time range to be aligned to the granularity
+	start, end := usageTimeRange(d.Quals, time.Now().UTC())
+	start, end = alignUsageTimeRange(granularity, start, end)
+
+	request.RequestSummarizedUsagesDetails = usageapi.RequestSummarizedUsagesDetails{
+		TenantId:         types.String(tenancyId),
+		TimeUsageStarted: &common.SDKTime{Time: start},
+		TimeUsageEnded:   &common.SDKTime{Time: end},
+		Granularity:      granularity,
+		QueryType:        queryType,
+		GroupBy:          groupBy,
+		GroupByTag:       groupByTag,
+	}
+
+	return request, info, nil
+}
+
+// usageTimeRange returns the time range of usage asked for by the
+// time_usage_started and time_usage_ended quals, which defaults to the 30 days
+// before now. Several quals on a column are intersected: the latest start and
+// the earliest end are used.
+func usageTimeRange(keyQuals plugin.KeyColumnQualMap, now time.Time) (time.Time, time.Time) {
+	var start, end time.Time
+	if keyQuals["time_usage_started"] != nil {
+		for _, q := range keyQuals["time_usage_started"].Quals {
+			value := q.Value.GetTimestampValue().AsTime().UTC()
+			if start.IsZero() || value.After(start) {
+				start = value
+			}
+		}
+	}
+	if keyQuals["time_usage_ended"] != nil {
+		for _, q := range keyQuals["time_usage_ended"].Quals {
+			value := q.Value.GetTimestampValue().AsTime().UTC()
+			if end.IsZero() || value.Before(end) {
+				end = value
+			}
+		}
+	}
+
+	if start.IsZero() {
+		start = now.AddDate(0, 0, -30)
+	}
+	if end.IsZero() {
+		end = now
+	}
+	return start, end
+}
+
+// alignUsageTimeRange truncates the start time and rounds up the end time to
+// the boundaries of the granularity
+func alignUsageTimeRange(granularity usageapi.RequestSummarizedUsagesDetailsGranularityEnum, start time.Time, end time.Time) (time.Time, time.Time) {
+	switch granularity {
+	case usageapi.RequestSummarizedUsagesDetailsGranularityHourly:
+		alignedEnd := end.Truncate(time.Hour)
+		if alignedEnd.Before(end) {
+			alignedEnd = alignedEnd.Add(time.Hour)
+		}
+		return start.Truncate(time.Hour), alignedEnd
+	case usageapi.RequestSummarizedUsagesDetailsGranularityMonthly:
+		alignedStart := time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, time.UTC)
+		alignedEnd := time.Date(end.Year(), end.Month(), 1, 0, 0, 0, 0, time.UTC)
+		if alignedEnd.Before(end) {
+			alignedEnd = alignedEnd.AddDate(0, 1, 0)
+		}
+		return alignedStart, alignedEnd
+	}
+
+	alignedStart := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	alignedEnd := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.UTC)
+	if alignedEnd.Before(end) {
+		alignedEnd = alignedEnd.AddDate(0, 0, 1)
+	}
+	return alignedStart, alignedEnd
+}
+
+func streamUsageSummaries(ctx context.Context, d *plugin.QueryData, session *session, request usageapi.RequestSummarizedUsagesRequest, info usageSummaryInfo) error {
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.UsageapiClient.RequestSummarizedUsages(ctx, request)
+		if err != nil {
+			plugin.Logger(ctx).Error("streamUsageSummaries", "api_error", err)
+			return err
+		}
+
+		for _, item := range response.Items {
+			row := info
+			row.UsageSummary = item
+			d.StreamListItem(ctx, row)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package oci
+
+import (
+	"testing"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/quals"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func usageTimeQuals(column string, operatorValues ...interface{}) *plugin.KeyColumnQuals {
+	keyQuals := &plugin.KeyColumnQuals{Name: column}
+	for i := 0; i < len(operatorValues); i += 2 {
+		keyQuals.Quals = append(keyQuals.Quals, &quals.Qual{
+			Column:   column,
+			Operator: operatorValues[i].(string),
+			Value: &proto.QualValue{Value: &proto.QualValue_TimestampValue{
+				TimestampValue: timestamppb.New(operatorValues[i+1].(time.Time)),
+			}},
+		})
+	}
+	return keyQuals
+}
+
+func TestUsageTimeRange(t *testing.T) {
+	now := time.Date(2022, 10, 20, 12, 0, 0, 0, time.UTC)
+	jan := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	feb := time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC)
+	mar := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	apr := time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		keyQuals      plugin.KeyColumnQualMap
+		expectedStart time.Time
+		expectedEnd   time.Time
+	}{
+		{
+			name:          "defaults to the last 30 days",
+			keyQuals:      plugin.KeyColumnQualMap{},
+			expectedStart: now.AddDate(0, 0, -30),
+			expectedEnd:   now,
+		},
+		{
+			name: "single bounds",
+			keyQuals: plugin.KeyColumnQualMap{
+				"time_usage_started": usageTimeQuals("time_usage_started", ">=", jan),
+				"time_usage_ended":   usageTimeQuals("time_usage_ended", "<", mar),
+			},
+			expectedStart: jan,
+			expectedEnd:   mar,
+		},
+		{
+			name: "several bounds are intersected",
+			keyQuals: plugin.KeyColumnQualMap{
+				"time_usage_started": usageTimeQuals("time_usage_started", ">=", feb, ">", jan),
+				"time_usage_ended":   usageTimeQuals("time_usage_ended", "<", mar, "<=", apr),
+			},
+			expectedStart: feb,
+			expectedEnd:   mar,
+		},
+		{
+			name: "only a start",
+			keyQuals: plugin.KeyColumnQualMap{
+				"time_usage_started": usageTimeQuals("time_usage_started", "=", jan, ">", feb),
+			},
+			expectedStart: feb,
+			expectedEnd:   now,
+		},
+	}
+
+	for _, test := range tests {
+		start, end := usageTimeRange(test.keyQuals, now)
+		if !start.Equal(test.expectedStart) || !end.Equal(test.expectedEnd) {
+			t.Errorf("%s: expected %s - %s, got %s - %s", test.name, test.expectedStart, test.expectedEnd, start, end)
+		}
+	}
+}
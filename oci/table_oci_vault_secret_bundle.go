@@ -0,0 +1,284 @@
+package oci
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/secrets"
+	"github.com/oracle/oci-go-sdk/v65/vault"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableVaultSecretBundle(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_vault_secret_bundle",
+		Description:      "OCI Vault Secret Bundle",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			ParentHydrate: listVaultSecrets,
+			Hydrate:       listVaultSecretBundles,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "secret_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "stage",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "version_number",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "vault_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "secret_id",
+				Description: "The OCID of the secret.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "secret_name",
+				Description: "The name of the secret.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "stage",
+				Description: "The rotation state of the secret version that was fetched. Defaults to CURRENT unless a version_number is given.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "version_number",
+				Description: "The version number of the secret.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "version_name",
+				Description: "The name of the secret version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "stages",
+				Description: "A list of possible rotation states for the secret version.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "content_hmac",
+				Description: "The hex encoded HMAC-SHA256 of the secret contents, keyed with a key derived from the tenancy and the secret_content_hmac_key connection option. It is stable across queries and plugin restarts, so it can be compared across versions and over time without exposing the contents.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "content",
+				Description: "The secret contents. Only returned if allow_secret_content is set to true in the connection config.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_created",
+				Description: "The time the secret version was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeCreated.Time"),
+			},
+			{
+				Name:        "time_of_deletion",
+				Description: "An optional property indicating when to delete the secret version.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeOfDeletion.Time"),
+			},
+			{
+				Name:        "time_of_expiry",
+				Description: "An optional property indicating when the secret version will expire.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeOfExpiry.Time"),
+			},
+			{
+				Name:        "metadata",
+				Description: "Customer-provided contextual metadata for the secret.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "vault_id",
+				Description: "The OCID of the vault that contains the secret.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SecretName"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SecretId").Transform(ociRegionName),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+// vaultSecretBundleInfo is a secret bundle without its base64 content, which
+// is replaced by a keyed hash and, only when allowed, the decoded content
+type vaultSecretBundleInfo struct {
+	SecretId       *string
+	SecretName     *string
+	Stage          string
+	VersionNumber  *int64
+	VersionName    *string
+	Stages         []secrets.SecretBundleStagesEnum
+	ContentHmac    string
+	Content        *string
+	TimeCreated    *common.SDKTime
+	TimeOfDeletion *common.SDKTime
+	TimeOfExpiry   *common.SDKTime
+	Metadata       map[string]interface{}
+	VaultId        *string
+	CompartmentId  *string
+}
+
+//// LIST FUNCTION
+
+func listVaultSecretBundles(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	secret := h.Item.(vault.SecretSummary)
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given secret_id doesn't match
+	if equalQuals["secret_id"] != nil && types.SafeString(secret.Id) != equalQuals["secret_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// Secrets pending deletion cannot be read
+	if secret.LifecycleState != vault.SecretSummaryLifecycleStateActive {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := secretsService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_vault_secret_bundle.listVaultSecretBundles", "connection_error", err)
+		return nil, err
+	}
+
+	request := secrets.GetSecretBundleRequest{
+		SecretId: secret.Id,
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	// Fetch the current version unless a version or stage is given
+	stage := ""
+	if equalQuals["version_number"] != nil {
+		request.VersionNumber = types.Int64(equalQuals["version_number"].GetInt64Value())
+	} else {
+		stage = string(secrets.GetSecretBundleStageCurrent)
+	}
+	if equalQuals["stage"] != nil {
+		stage = equalQuals["stage"].GetStringValue()
+	}
+	if stage != "" {
+		value, ok := secrets.GetMappingGetSecretBundleStageEnum(stage)
+		if !ok {
+			return nil, nil
+		}
+		request.Stage = value
+	}
+
+	response, err := session.SecretsClient.GetSecretBundle(ctx, request)
+	if err != nil {
+		// The requested stage may not exist for the secret
+		if ociErr, ok := err.(common.ServiceError); ok && ociErr.GetHTTPStatusCode() == 404 {
+			return nil, nil
+		}
+		logger.Error("oci_vault_secret_bundle.listVaultSecretBundles", "api_error", err)
+		return nil, err
+	}
+
+	bundle := response.SecretBundle
+	info := vaultSecretBundleInfo{
+		SecretId:       bundle.SecretId,
+		SecretName:     secret.SecretName,
+		Stage:          stage,
+		VersionNumber:  bundle.VersionNumber,
+		VersionName:    bundle.VersionName,
+		Stages:         bundle.Stages,
+		TimeCreated:    bundle.TimeCreated,
+		TimeOfDeletion: bundle.TimeOfDeletion,
+		TimeOfExpiry:   bundle.TimeOfExpiry,
+		Metadata:       bundle.Metadata,
+		VaultId:        secret.VaultId,
+		CompartmentId:  secret.CompartmentId,
+	}
+
+	if content, ok := bundle.SecretBundleContent.(secrets.Base64SecretBundleContentDetails); ok && content.Content != nil {
+		decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(*content.Content))
+		if err != nil {
+			logger.Error("oci_vault_secret_bundle.listVaultSecretBundles", "decode_error", err)
+			return nil, err
+		}
+		ociConfig := GetConfig(d.Connection)
+		info.ContentHmac = secretContentHmac(secretContentHmacKey(session.TenancyID, types.SafeString(ociConfig.SecretContentHmacKey)), decoded)
+
+		// Only expose the secret contents when explicitly allowed
+		if ociConfig.AllowSecretContent != nil && *ociConfig.AllowSecretContent {
+			info.Content = types.String(string(decoded))
+		}
+	}
+
+	d.StreamLeafListItem(ctx, info)
+
+	return nil, nil
+}
+
+// secretContentHmacKey derives the key of the secret content HMAC from the
+// tenancy and the secret_content_hmac_key connection option, so that the HMAC
+// of the same content is the same across queries, plugin restarts and
+// machines using the same key.
+func secretContentHmacKey(tenancyId string, configuredKey string) []byte {
+	key := sha256.Sum256([]byte("steampipe-plugin-oci/secret-content-hmac\x00" + tenancyId + "\x00" + configuredKey))
+	return key[:]
+}
+
+// secretContentHmac returns the hex encoded HMAC-SHA256 of secret contents
+func secretContentHmac(key []byte, content []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(content)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,31 @@
+package oci
+
+import (
+	"testing"
+)
+
+func TestSecretContentHmac(t *testing.T) {
+	content := []byte("hunter2")
+
+	// keys derived separately, e.g. by two plugin processes, give the same HMAC
+	first := secretContentHmac(secretContentHmacKey("ocid1.tenancy.oc1..aaaa", "key"), content)
+	second := secretContentHmac(secretContentHmacKey("ocid1.tenancy.oc1..aaaa", "key"), content)
+	if first != second {
+		t.Errorf("expected the same HMAC for the same content, got %s and %s", first, second)
+	}
+	if len(first) != 64 {
+		t.Errorf("expected a hex encoded SHA-256 HMAC, got %s", first)
+	}
+
+	others := map[string]string{
+		"other content": secretContentHmac(secretContentHmacKey("ocid1.tenancy.oc1..aaaa", "key"), []byte("hunter3")),
+		"other tenancy": secretContentHmac(secretContentHmacKey("ocid1.tenancy.oc1..bbbb", "key"), content),
+		"other key":     secretContentHmac(secretContentHmacKey("ocid1.tenancy.oc1..aaaa", "other"), content),
+		"no key":        secretContentHmac(secretContentHmacKey("ocid1.tenancy.oc1..aaaa", ""), content),
+	}
+	for name, other := range others {
+		if other == first {
+			t.Errorf("%s: expected a different HMAC", name)
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package oci
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/vault"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableVaultSecretVersion(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_vault_secret_version",
+		Description:      "OCI Vault Secret Version",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			ParentHydrate: listVaultSecrets,
+			Hydrate:       listVaultSecretVersions,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "secret_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "vault_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "secret_id",
+				Description: "The OCID of the secret.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "version_number",
+				Description: "The version number of the secret.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "name",
+				Description: "The name of the secret version. A name is unique across versions of a secret.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "secret_name",
+				Description: "The name of the secret.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "content_type",
+				Description: "The content type of the secret version's secret contents.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "stages",
+				Description: "A list of possible rotation states for the secret version, e.g. CURRENT, PENDING, LATEST, PREVIOUS or DEPRECATED.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "time_created",
+				Description: "The time the secret version was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeCreated.Time"),
+			},
+			{
+				Name:        "time_of_deletion",
+				Description: "An optional property indicating when to delete the secret version.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeOfDeletion.Time"),
+			},
+			{
+				Name:        "time_of_expiry",
+				Description: "An optional property indicating when the secret version will expire.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeOfExpiry.Time"),
+			},
+			{
+				Name:        "vault_id",
+				Description: "The OCID of the vault that contains the secret.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(vaultSecretVersionTitle),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SecretId").Transform(ociRegionName),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+type vaultSecretVersionInfo struct {
+	vault.SecretVersionSummary
+	SecretName    *string
+	VaultId       *string
+	CompartmentId *string
+}
+
+//// LIST FUNCTION
+
+func listVaultSecretVersions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	secret := h.Item.(vault.SecretSummary)
+
+	// Return nil, if given secret_id doesn't match
+	if d.KeyColumnQuals["secret_id"] != nil && types.SafeString(secret.Id) != d.KeyColumnQuals["secret_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := vaultService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_vault_secret_version.listVaultSecretVersions", "connection_error", err)
+		return nil, err
+	}
+
+	request := vault.ListSecretVersionsRequest{
+		SecretId: secret.Id,
+		Limit:    types.Int(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.VaultClient.ListSecretVersions(ctx, request)
+		if err != nil {
+			logger.Error("oci_vault_secret_version.listVaultSecretVersions", "api_error", err)
+			return nil, err
+		}
+
+		for _, version := range response.Items {
+			d.StreamLeafListItem(ctx, vaultSecretVersionInfo{version, secret.SecretName, secret.VaultId, secret.CompartmentId})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTION
+
+func vaultSecretVersionTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	version := d.HydrateItem.(vaultSecretVersionInfo)
+	if version.Name != nil {
+		return *version.Name, nil
+	}
+	return fmt.Sprintf("%s-%d", types.SafeString(version.SecretName), types.Int64Value(version.VersionNumber)), nil
+}
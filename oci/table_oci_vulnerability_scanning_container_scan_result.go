@@ -0,0 +1,282 @@
+package oci
+
+import (
+	"context"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/vulnerabilityscanning"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableVulnerabilityScanningContainerScanResult(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_vulnerability_scanning_container_scan_result",
+		Description:      "OCI Vulnerability Scanning Container Scan Result",
+		DefaultTransform: transform.FromCamel(),
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getVulnerabilityScanningContainerScanResult,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listVulnerabilityScanningContainerScanResults,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "repository",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "image",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "highest_problem_severity",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The OCID of the container scan result.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "repository",
+				Description: "The repository of the scanned image.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "image",
+				Description: "The name of the scanned image.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "highest_problem_severity",
+				Description: "The highest severity of the vulnerabilities found in the image.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "problem_count",
+				Description: "The number of vulnerabilities found in the image.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "container_scan_target_id",
+				Description: "The OCID of the container scan target that triggered the scan.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_started",
+				Description: "The date and time the scan started.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeStarted.Time"),
+			},
+			{
+				Name:        "time_finished",
+				Description: "The date and time the scan finished.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeFinished.Time"),
+			},
+			{
+				Name:        "registry_url",
+				Description: "The URL of the registry the image was pulled from.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getVulnerabilityScanningContainerScanResult,
+			},
+			{
+				Name:        "target_compartment_id",
+				Description: "The OCID of the compartment of the repository.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getVulnerabilityScanningContainerScanResult,
+			},
+
+			// json fields
+			{
+				Name:        "problems",
+				Description: "The vulnerabilities found in the image, with their CVE, severity and affected packages.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getVulnerabilityScanningContainerScanResult,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(vulnerabilityScanningContainerScanResultTitle),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id").Transform(ociRegionName),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listVulnerabilityScanningContainerScanResults(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Trace("listVulnerabilityScanningContainerScanResults", "Compartment", compartment, "OCI_REGION", region)
+
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given compartment_id doesn't match
+	if equalQuals["compartment_id"] != nil && compartment != equalQuals["compartment_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := vulnerabilityScanningService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_vulnerability_scanning_container_scan_result.listVulnerabilityScanningContainerScanResults", "connection_error", err)
+		return nil, err
+	}
+
+	// Build request parameters
+	request := buildVulnerabilityScanningContainerScanResultFilters(equalQuals)
+	request.CompartmentId = types.String(compartment)
+	request.IsLatestOnly = types.Bool(true)
+	request.Limit = types.Int(1000)
+	request.RequestMetadata = common.RequestMetadata{
+		RetryPolicy: getDefaultRetryPolicy(d.Connection),
+	}
+
+	// Check for limit
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < int64(*request.Limit) {
+			request.Limit = types.Int(int(*limit))
+		}
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.VulnerabilityScanningClient.ListContainerScanResults(ctx, request)
+		if err != nil {
+			logger.Error("oci_vulnerability_scanning_container_scan_result.listVulnerabilityScanningContainerScanResults", "api_error", err)
+			return nil, err
+		}
+
+		for _, result := range response.Items {
+			d.StreamListItem(ctx, result)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTION
+
+func getVulnerabilityScanningContainerScanResult(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Trace("getVulnerabilityScanningContainerScanResult", "Compartment", compartment, "OCI_REGION", region)
+
+	var id string
+	if h.Item != nil {
+		id = *h.Item.(vulnerabilityscanning.ContainerScanResultSummary).Id
+	} else {
+		id = d.KeyColumnQuals["id"].GetStringValue()
+		// Restrict the api call to only root compartment/ per region
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+			return nil, nil
+		}
+	}
+
+	// handle empty id in get call
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := vulnerabilityScanningService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_vulnerability_scanning_container_scan_result.getVulnerabilityScanningContainerScanResult", "connection_error", err)
+		return nil, err
+	}
+
+	request := vulnerabilityscanning.GetContainerScanResultRequest{
+		ContainerScanResultId: types.String(id),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.VulnerabilityScanningClient.GetContainerScanResult(ctx, request)
+	if err != nil {
+		logger.Error("oci_vulnerability_scanning_container_scan_result.getVulnerabilityScanningContainerScanResult", "api_error", err)
+		return nil, err
+	}
+
+	return response.ContainerScanResult, nil
+}
+
+//// TRANSFORM FUNCTION
+
+func vulnerabilityScanningContainerScanResultTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	switch result := d.HydrateItem.(type) {
+	case vulnerabilityscanning.ContainerScanResultSummary:
+		return types.SafeString(result.Repository) + ":" + types.SafeString(result.Image), nil
+	case vulnerabilityscanning.ContainerScanResult:
+		return types.SafeString(result.Repository) + ":" + types.SafeString(result.Image), nil
+	}
+	return nil, nil
+}
+
+// Build additional filters
+func buildVulnerabilityScanningContainerScanResultFilters(equalQuals plugin.KeyColumnEqualsQualMap) vulnerabilityscanning.ListContainerScanResultsRequest {
+	request := vulnerabilityscanning.ListContainerScanResultsRequest{}
+
+	if equalQuals["repository"] != nil {
+		request.Repository = types.String(equalQuals["repository"].GetStringValue())
+	}
+	if equalQuals["image"] != nil {
+		request.Image = types.String(equalQuals["image"].GetStringValue())
+	}
+	if equalQuals["highest_problem_severity"] != nil {
+		request.HighestProblemSeverity = vulnerabilityscanning.ListContainerScanResultsHighestProblemSeverityEnum(equalQuals["highest_problem_severity"].GetStringValue())
+	}
+
+	return request
+}
@@ -0,0 +1,304 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/vulnerabilityscanning"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableVulnerabilityScanningHostAgentScanResult(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_vulnerability_scanning_host_agent_scan_result",
+		Description:      "OCI Vulnerability Scanning Host Agent Scan Result",
+		DefaultTransform: transform.FromCamel(),
+		List: &plugin.ListConfig{
+			Hydrate: listVulnerabilityScanningHostAgentScanResults,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "instance_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "operating_system",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "cve_reference",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "severity",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "state",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "instance_id",
+				Description: "The OCID of the scanned instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cve_reference",
+				Description: "The CVE of the vulnerability, e.g. CVE-2021-44228.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "name",
+				Description: "The name of the vulnerability.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "severity",
+				Description: "The severity of the vulnerability, e.g. CRITICAL, HIGH, MEDIUM, LOW or NONE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the vulnerability on the instance, e.g. OPEN, FIXED or NOT_APPLICABLE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the vulnerability.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "issue_id",
+				Description: "The id of the issue reported by the scanning vendor.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "time_first_detected",
+				Description: "The date and time the vulnerability was first detected on the instance.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeFirstDetected.Time"),
+			},
+			{
+				Name:        "time_last_detected",
+				Description: "The date and time the vulnerability was last detected on the instance.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeLastDetected.Time"),
+			},
+			{
+				Name:        "scan_result_id",
+				Description: "The OCID of the host agent scan result.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "instance_display_name",
+				Description: "The display name of the scanned instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "operating_system",
+				Description: "The operating system of the instance, as reported by the agent.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kernel_version",
+				Description: "The kernel version of the instance, as reported by the agent.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vendor",
+				Description: "The vendor of the scanning engine.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_scan_started",
+				Description: "The date and time the scan started.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeScanStarted.Time"),
+			},
+			{
+				Name:        "time_scan_finished",
+				Description: "The date and time the scan finished.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeScanFinished.Time"),
+			},
+
+			// json fields
+			{
+				Name:        "vulnerable_packages",
+				Description: "The installed packages affected by the vulnerability, with the version that fixes it.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CveReference", "Name"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+// hostAgentScanResultProblemInfo is a vulnerability found on an instance by
+// its latest agent scan
+type hostAgentScanResultProblemInfo struct {
+	vulnerabilityscanning.HostAgentScanResultProblem
+	ScanResultId        *string
+	InstanceId          *string
+	InstanceDisplayName *string
+	OperatingSystem     *string
+	KernelVersion       *string
+	Vendor              vulnerabilityscanning.VendorTypeEnum
+	TimeScanStarted     *common.SDKTime
+	TimeScanFinished    *common.SDKTime
+	Region              string
+	CompartmentId       *string
+}
+
+//// LIST FUNCTION
+
+func listVulnerabilityScanningHostAgentScanResults(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Trace("listVulnerabilityScanningHostAgentScanResults", "Compartment", compartment, "OCI_REGION", region)
+
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given compartment_id doesn't match
+	if equalQuals["compartment_id"] != nil && compartment != equalQuals["compartment_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := vulnerabilityScanningService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_vulnerability_scanning_host_agent_scan_result.listVulnerabilityScanningHostAgentScanResults", "connection_error", err)
+		return nil, err
+	}
+
+	// Only the latest scan of each instance reflects its current vulnerabilities
+	request := vulnerabilityscanning.ListHostAgentScanResultsRequest{
+		CompartmentId: types.String(compartment),
+		IsLatestOnly:  types.Bool(true),
+		Limit:         types.Int(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	if equalQuals["instance_id"] != nil {
+		request.InstanceId = types.String(equalQuals["instance_id"].GetStringValue())
+	}
+	if equalQuals["operating_system"] != nil {
+		request.OperatingSystem = types.String(equalQuals["operating_system"].GetStringValue())
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.VulnerabilityScanningClient.ListHostAgentScanResults(ctx, request)
+		if err != nil {
+			logger.Error("oci_vulnerability_scanning_host_agent_scan_result.listVulnerabilityScanningHostAgentScanResults", "api_error", err)
+			return nil, err
+		}
+
+		for _, summary := range response.Items {
+			if summary.ProblemCount != nil && *summary.ProblemCount == 0 {
+				continue
+			}
+
+			// The problems are only returned by the get call
+			result, err := session.VulnerabilityScanningClient.GetHostAgentScanResult(ctx, vulnerabilityscanning.GetHostAgentScanResultRequest{
+				HostAgentScanResultId: summary.Id,
+				RequestMetadata: common.RequestMetadata{
+					RetryPolicy: getDefaultRetryPolicy(d.Connection),
+				},
+			})
+			if err != nil {
+				if ociErr, ok := err.(common.ServiceError); ok && ociErr.GetHTTPStatusCode() == 404 {
+					continue
+				}
+				logger.Error("oci_vulnerability_scanning_host_agent_scan_result.listVulnerabilityScanningHostAgentScanResults", "api_error", err)
+				return nil, err
+			}
+
+			for _, problem := range result.Problems {
+				if !hostAgentScanResultProblemMatches(problem, equalQuals) {
+					continue
+				}
+
+				d.StreamListItem(ctx, hostAgentScanResultProblemInfo{
+					HostAgentScanResultProblem: problem,
+					ScanResultId:               result.Id,
+					InstanceId:                 result.InstanceId,
+					InstanceDisplayName:        result.DisplayName,
+					OperatingSystem:            result.OperatingSystem,
+					KernelVersion:              result.KernelVersion,
+					Vendor:                     result.Vendor,
+					TimeScanStarted:            result.TimeStarted,
+					TimeScanFinished:           result.TimeFinished,
+					Region:                     region,
+					CompartmentId:              result.CompartmentId,
+				})
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+// hostAgentScanResultProblemMatches applies the quals that the API can't filter on
+func hostAgentScanResultProblemMatches(problem vulnerabilityscanning.HostAgentScanResultProblem, equalQuals plugin.KeyColumnEqualsQualMap) bool {
+	if equalQuals["cve_reference"] != nil && types.SafeString(problem.CveReference) != equalQuals["cve_reference"].GetStringValue() {
+		return false
+	}
+	if equalQuals["severity"] != nil && string(problem.Severity) != equalQuals["severity"].GetStringValue() {
+		return false
+	}
+	if equalQuals["state"] != nil && string(problem.State) != equalQuals["state"].GetStringValue() {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,332 @@
+package oci
+
+import (
+	"context"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/vulnerabilityscanning"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableVulnerabilityScanningHostScanRecipe(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_vulnerability_scanning_host_scan_recipe",
+		Description:      "OCI Vulnerability Scanning Host Scan Recipe",
+		DefaultTransform: transform.FromCamel(),
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getVulnerabilityScanningHostScanRecipe,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listVulnerabilityScanningHostScanRecipes,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "display_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "lifecycle_state",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "display_name",
+				Description: "The name of the host scan recipe.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The OCID of the host scan recipe.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_state",
+				Description: "The current state of the host scan recipe.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_created",
+				Description: "The date and time the host scan recipe was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeCreated.Time"),
+			},
+			{
+				Name:        "time_updated",
+				Description: "The date and time the host scan recipe was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeUpdated.Time"),
+			},
+
+			// json fields
+			{
+				Name:        "port_settings",
+				Description: "The port scan settings of the recipe.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getVulnerabilityScanningHostScanRecipe,
+			},
+			{
+				Name:        "agent_settings",
+				Description: "The agent scan settings of the recipe, including the scan level and vendor.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getVulnerabilityScanningHostScanRecipe,
+			},
+			{
+				Name:        "application_settings",
+				Description: "The application scan settings of the recipe.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getVulnerabilityScanningHostScanRecipe,
+			},
+			{
+				Name:        "schedule",
+				Description: "The schedule of the scans.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getVulnerabilityScanningHostScanRecipe,
+			},
+
+			// tags
+			{
+				Name:        "defined_tags",
+				Description: ColumnDescriptionDefinedTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "freeform_tags",
+				Description: ColumnDescriptionFreefromTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "system_tags",
+				Description: ColumnDescriptionSystemTags,
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(vulnerabilityScanningHostScanRecipeTags),
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id").Transform(ociRegionName),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listVulnerabilityScanningHostScanRecipes(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Trace("listVulnerabilityScanningHostScanRecipes", "Compartment", compartment, "OCI_REGION", region)
+
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given compartment_id doesn't match
+	if equalQuals["compartment_id"] != nil && compartment != equalQuals["compartment_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := vulnerabilityScanningService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_vulnerability_scanning_host_scan_recipe.listVulnerabilityScanningHostScanRecipes", "connection_error", err)
+		return nil, err
+	}
+
+	// Build request parameters
+	request := buildVulnerabilityScanningHostScanRecipeFilters(equalQuals)
+	request.CompartmentId = types.String(compartment)
+	request.Limit = types.Int(1000)
+	request.RequestMetadata = common.RequestMetadata{
+		RetryPolicy: getDefaultRetryPolicy(d.Connection),
+	}
+
+	// Check for limit
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < int64(*request.Limit) {
+			request.Limit = types.Int(int(*limit))
+		}
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.VulnerabilityScanningClient.ListHostScanRecipes(ctx, request)
+		if err != nil {
+			logger.Error("oci_vulnerability_scanning_host_scan_recipe.listVulnerabilityScanningHostScanRecipes", "api_error", err)
+			return nil, err
+		}
+
+		for _, recipe := range response.Items {
+			d.StreamListItem(ctx, recipe)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTION
+
+func getVulnerabilityScanningHostScanRecipe(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Trace("getVulnerabilityScanningHostScanRecipe", "Compartment", compartment, "OCI_REGION", region)
+
+	var id string
+	if h.Item != nil {
+		id = *h.Item.(vulnerabilityscanning.HostScanRecipeSummary).Id
+	} else {
+		id = d.KeyColumnQuals["id"].GetStringValue()
+		// Restrict the api call to only root compartment/ per region
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+			return nil, nil
+		}
+	}
+
+	// handle empty id in get call
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := vulnerabilityScanningService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_vulnerability_scanning_host_scan_recipe.getVulnerabilityScanningHostScanRecipe", "connection_error", err)
+		return nil, err
+	}
+
+	request := vulnerabilityscanning.GetHostScanRecipeRequest{
+		HostScanRecipeId: types.String(id),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.VulnerabilityScanningClient.GetHostScanRecipe(ctx, request)
+	if err != nil {
+		logger.Error("oci_vulnerability_scanning_host_scan_recipe.getVulnerabilityScanningHostScanRecipe", "api_error", err)
+		return nil, err
+	}
+
+	return response.HostScanRecipe, nil
+}
+
+//// TRANSFORM FUNCTION
+
+// Priority order for tags
+// 1. System Tags
+// 2. Defined Tags
+// 3. Free-form tags
+func vulnerabilityScanningHostScanRecipeTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	var freeformTags map[string]string
+	var definedTags map[string]map[string]interface{}
+	var systemTags map[string]map[string]interface{}
+
+	switch recipe := d.HydrateItem.(type) {
+	case vulnerabilityscanning.HostScanRecipeSummary:
+		freeformTags = recipe.FreeformTags
+		definedTags = recipe.DefinedTags
+		systemTags = recipe.SystemTags
+	case vulnerabilityscanning.HostScanRecipe:
+		freeformTags = recipe.FreeformTags
+		definedTags = recipe.DefinedTags
+		systemTags = recipe.SystemTags
+	}
+
+	var tags map[string]interface{}
+
+	if freeformTags != nil {
+		tags = map[string]interface{}{}
+		for k, v := range freeformTags {
+			tags[k] = v
+		}
+	}
+
+	if definedTags != nil {
+		if tags == nil {
+			tags = map[string]interface{}{}
+		}
+		for _, v := range definedTags {
+			for key, value := range v {
+				tags[key] = value
+			}
+		}
+	}
+
+	if systemTags != nil {
+		if tags == nil {
+			tags = map[string]interface{}{}
+		}
+		for _, v := range systemTags {
+			for key, value := range v {
+				tags[key] = value
+			}
+		}
+	}
+
+	return tags, nil
+}
+
+// Build additional filters
+func buildVulnerabilityScanningHostScanRecipeFilters(equalQuals plugin.KeyColumnEqualsQualMap) vulnerabilityscanning.ListHostScanRecipesRequest {
+	request := vulnerabilityscanning.ListHostScanRecipesRequest{}
+
+	if equalQuals["display_name"] != nil {
+		request.DisplayName = types.String(equalQuals["display_name"].GetStringValue())
+	}
+	if equalQuals["lifecycle_state"] != nil {
+		request.LifecycleState = vulnerabilityscanning.ListHostScanRecipesLifecycleStateEnum(equalQuals["lifecycle_state"].GetStringValue())
+	}
+
+	return request
+}
@@ -0,0 +1,329 @@
+package oci
+
+import (
+	"context"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/vulnerabilityscanning"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableVulnerabilityScanningHostScanTarget(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:             "oci_vulnerability_scanning_host_scan_target",
+		Description:      "OCI Vulnerability Scanning Host Scan Target",
+		DefaultTransform: transform.FromCamel(),
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getVulnerabilityScanningHostScanTarget,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listVulnerabilityScanningHostScanTargets,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "display_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "lifecycle_state",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "display_name",
+				Description: "The name of the host scan target.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The OCID of the host scan target.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "host_scan_recipe_id",
+				Description: "The OCID of the host scan recipe used by the target.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "target_compartment_id",
+				Description: "The OCID of the compartment whose instances are scanned.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the host scan target.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getVulnerabilityScanningHostScanTarget,
+			},
+			{
+				Name:        "lifecycle_state",
+				Description: "The current state of the host scan target.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_created",
+				Description: "The date and time the host scan target was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeCreated.Time"),
+			},
+			{
+				Name:        "time_updated",
+				Description: "The date and time the host scan target was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimeUpdated.Time"),
+			},
+
+			// json fields
+			{
+				Name:        "instance_ids",
+				Description: "The OCIDs of the instances scanned by the target. If empty, all instances in the target compartment and its subcompartments are scanned.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// tags
+			{
+				Name:        "defined_tags",
+				Description: ColumnDescriptionDefinedTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "freeform_tags",
+				Description: ColumnDescriptionFreefromTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "system_tags",
+				Description: ColumnDescriptionSystemTags,
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(vulnerabilityScanningHostScanTargetTags),
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id").Transform(ociRegionName),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listVulnerabilityScanningHostScanTargets(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Trace("listVulnerabilityScanningHostScanTargets", "Compartment", compartment, "OCI_REGION", region)
+
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given compartment_id doesn't match
+	if equalQuals["compartment_id"] != nil && compartment != equalQuals["compartment_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := vulnerabilityScanningService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_vulnerability_scanning_host_scan_target.listVulnerabilityScanningHostScanTargets", "connection_error", err)
+		return nil, err
+	}
+
+	// Build request parameters
+	request := buildVulnerabilityScanningHostScanTargetFilters(equalQuals)
+	request.CompartmentId = types.String(compartment)
+	request.Limit = types.Int(1000)
+	request.RequestMetadata = common.RequestMetadata{
+		RetryPolicy: getDefaultRetryPolicy(d.Connection),
+	}
+
+	// Check for limit
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < int64(*request.Limit) {
+			request.Limit = types.Int(int(*limit))
+		}
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.VulnerabilityScanningClient.ListHostScanTargets(ctx, request)
+		if err != nil {
+			logger.Error("oci_vulnerability_scanning_host_scan_target.listVulnerabilityScanningHostScanTargets", "api_error", err)
+			return nil, err
+		}
+
+		for _, target := range response.Items {
+			d.StreamListItem(ctx, target)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTION
+
+func getVulnerabilityScanningHostScanTarget(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Trace("getVulnerabilityScanningHostScanTarget", "Compartment", compartment, "OCI_REGION", region)
+
+	var id string
+	if h.Item != nil {
+		id = *h.Item.(vulnerabilityscanning.HostScanTargetSummary).Id
+	} else {
+		id = d.KeyColumnQuals["id"].GetStringValue()
+		// Restrict the api call to only root compartment/ per region
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+			return nil, nil
+		}
+	}
+
+	// handle empty id in get call
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := vulnerabilityScanningService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_vulnerability_scanning_host_scan_target.getVulnerabilityScanningHostScanTarget", "connection_error", err)
+		return nil, err
+	}
+
+	request := vulnerabilityscanning.GetHostScanTargetRequest{
+		HostScanTargetId: types.String(id),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.VulnerabilityScanningClient.GetHostScanTarget(ctx, request)
+	if err != nil {
+		logger.Error("oci_vulnerability_scanning_host_scan_target.getVulnerabilityScanningHostScanTarget", "api_error", err)
+		return nil, err
+	}
+
+	return response.HostScanTarget, nil
+}
+
+//// TRANSFORM FUNCTION
+
+// Priority order for tags
+// 1. System Tags
+// 2. Defined Tags
+// 3. Free-form tags
+func vulnerabilityScanningHostScanTargetTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	var freeformTags map[string]string
+	var definedTags map[string]map[string]interface{}
+	var systemTags map[string]map[string]interface{}
+
+	switch target := d.HydrateItem.(type) {
+	case vulnerabilityscanning.HostScanTargetSummary:
+		freeformTags = target.FreeformTags
+		definedTags = target.DefinedTags
+		systemTags = target.SystemTags
+	case vulnerabilityscanning.HostScanTarget:
+		freeformTags = target.FreeformTags
+		definedTags = target.DefinedTags
+		systemTags = target.SystemTags
+	}
+
+	var tags map[string]interface{}
+
+	if freeformTags != nil {
+		tags = map[string]interface{}{}
+		for k, v := range freeformTags {
+			tags[k] = v
+		}
+	}
+
+	if definedTags != nil {
+		if tags == nil {
+			tags = map[string]interface{}{}
+		}
+		for _, v := range definedTags {
+			for key, value := range v {
+				tags[key] = value
+			}
+		}
+	}
+
+	if systemTags != nil {
+		if tags == nil {
+			tags = map[string]interface{}{}
+		}
+		for _, v := range systemTags {
+			for key, value := range v {
+				tags[key] = value
+			}
+		}
+	}
+
+	return tags, nil
+}
+
+// Build additional filters
+func buildVulnerabilityScanningHostScanTargetFilters(equalQuals plugin.KeyColumnEqualsQualMap) vulnerabilityscanning.ListHostScanTargetsRequest {
+	request := vulnerabilityscanning.ListHostScanTargetsRequest{}
+
+	if equalQuals["display_name"] != nil {
+		request.DisplayName = types.String(equalQuals["display_name"].GetStringValue())
+	}
+	if equalQuals["lifecycle_state"] != nil {
+		request.LifecycleState = vulnerabilityscanning.ListHostScanTargetsLifecycleStateEnum(equalQuals["lifecycle_state"].GetStringValue())
+	}
+
+	return request
+}
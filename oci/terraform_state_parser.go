@@ -0,0 +1,231 @@
+package oci
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// terraformStateResource is a managed resource instance found in a Terraform
+// state file
+type terraformStateResource struct {
+	Address       string
+	Type          string
+	Name          string
+	Module        string
+	IndexKey      *string
+	Provider      string
+	ProviderAlias *string
+	Id            *string
+	CompartmentId *string
+}
+
+type terraformState struct {
+	Version          int
+	TerraformVersion string
+	Resources        []terraformStateResource
+}
+
+// raw layout of a version 4 state file, as written by Terraform 0.12 and later
+type terraformStateV4 struct {
+	Version          int    `json:"version"`
+	TerraformVersion string `json:"terraform_version"`
+	Resources        []struct {
+		Module    string `json:"module"`
+		Mode      string `json:"mode"`
+		Type      string `json:"type"`
+		Name      string `json:"name"`
+		Provider  string `json:"provider"`
+		Instances []struct {
+			IndexKey   interface{}            `json:"index_key"`
+			Attributes map[string]interface{} `json:"attributes"`
+		} `json:"instances"`
+	} `json:"resources"`
+}
+
+// raw layout of a version 3 state file, as written by Terraform 0.11
+type terraformStateV3 struct {
+	Version          int    `json:"version"`
+	TerraformVersion string `json:"terraform_version"`
+	Modules          []struct {
+		Path      []string `json:"path"`
+		Resources map[string]struct {
+			Type     string `json:"type"`
+			Provider string `json:"provider"`
+			Primary  struct {
+				Id         string            `json:"id"`
+				Attributes map[string]string `json:"attributes"`
+			} `json:"primary"`
+		} `json:"resources"`
+	} `json:"modules"`
+}
+
+// parseTerraformState parses a Terraform state file into its managed resource
+// instances. Data sources are skipped.
+func parseTerraformState(r io.Reader) (*terraformState, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(strings.TrimSpace(string(data))) == 0 {
+		return &terraformState{}, nil
+	}
+
+	var header struct {
+		Version int `json:"version"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, fmt.Errorf("invalid terraform state: %v", err)
+	}
+
+	switch header.Version {
+	case 4:
+		return parseTerraformStateV4(data)
+	case 3:
+		return parseTerraformStateV3(data)
+	}
+
+	return nil, fmt.Errorf("unsupported terraform state version %d", header.Version)
+}
+
+func parseTerraformStateV4(data []byte) (*terraformState, error) {
+	var raw terraformStateV4
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("invalid terraform state: %v", err)
+	}
+
+	state := &terraformState{Version: raw.Version, TerraformVersion: raw.TerraformVersion}
+	for _, resource := range raw.Resources {
+		if resource.Mode != "managed" {
+			continue
+		}
+		provider, alias := parseTerraformProvider(resource.Provider)
+
+		for _, instance := range resource.Instances {
+			item := terraformStateResource{
+				Type:          resource.Type,
+				Name:          resource.Name,
+				Module:        resource.Module,
+				Provider:      provider,
+				ProviderAlias: alias,
+				Id:            terraformStringAttribute(instance.Attributes["id"]),
+				CompartmentId: terraformStringAttribute(instance.Attributes["compartment_id"]),
+			}
+
+			address := resource.Type + "." + resource.Name
+			switch key := instance.IndexKey.(type) {
+			case float64:
+				index := strconv.FormatFloat(key, 'f', -1, 64)
+				item.IndexKey = &index
+				address = fmt.Sprintf("%s[%s]", address, index)
+			case string:
+				item.IndexKey = &key
+				address = fmt.Sprintf("%s[%q]", address, key)
+			}
+			if resource.Module != "" {
+				address = resource.Module + "." + address
+			}
+			item.Address = address
+
+			state.Resources = append(state.Resources, item)
+		}
+	}
+
+	return state, nil
+}
+
+func parseTerraformStateV3(data []byte) (*terraformState, error) {
+	var raw terraformStateV3
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("invalid terraform state: %v", err)
+	}
+
+	state := &terraformState{Version: raw.Version, TerraformVersion: raw.TerraformVersion}
+	for _, module := range raw.Modules {
+		var modulePath []string
+		for _, name := range module.Path {
+			if name != "root" {
+				modulePath = append(modulePath, "module."+name)
+			}
+		}
+		moduleAddress := strings.Join(modulePath, ".")
+
+		// Resources are keyed by type.name, with a .index suffix when counted
+		keys := make([]string, 0, len(module.Resources))
+		for key := range module.Resources {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			if strings.HasPrefix(key, "data.") {
+				continue
+			}
+			resource := module.Resources[key]
+			parts := strings.Split(key, ".")
+			if len(parts) < 2 {
+				continue
+			}
+			provider, alias := parseTerraformProvider(resource.Provider)
+
+			item := terraformStateResource{
+				Type:          parts[0],
+				Name:          parts[1],
+				Module:        moduleAddress,
+				Provider:      provider,
+				ProviderAlias: alias,
+				Id:            terraformStringAttribute(resource.Primary.Id),
+				CompartmentId: terraformStringAttribute(resource.Primary.Attributes["compartment_id"]),
+			}
+
+			address := parts[0] + "." + parts[1]
+			if len(parts) > 2 {
+				item.IndexKey = &parts[2]
+				address = fmt.Sprintf("%s[%s]", address, parts[2])
+			}
+			if moduleAddress != "" {
+				address = moduleAddress + "." + address
+			}
+			item.Address = address
+
+			state.Resources = append(state.Resources, item)
+		}
+	}
+
+	return state, nil
+}
+
+// parseTerraformProvider extracts the provider source address and alias from
+// the provider reference of a resource, e.g.
+// provider["registry.terraform.io/oracle/oci"].home or provider.oci.home
+func parseTerraformProvider(reference string) (string, *string) {
+	var alias *string
+
+	if strings.HasPrefix(reference, "provider[") {
+		end := strings.Index(reference, "]")
+		if end < 0 {
+			return reference, nil
+		}
+		provider := strings.Trim(reference[len("provider["):end], `"`)
+		if rest := strings.TrimPrefix(reference[end+1:], "."); rest != "" {
+			alias = &rest
+		}
+		return provider, alias
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(reference, "provider."), ".", 2)
+	if len(parts) == 2 {
+		alias = &parts[1]
+	}
+	return parts[0], alias
+}
+
+func terraformStringAttribute(value interface{}) *string {
+	if s, ok := value.(string); ok && s != "" {
+		return &s
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package oci
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func parseTerraformStateFixture(t *testing.T, name string) (*terraformState, error) {
+	t.Helper()
+
+	file, err := os.Open(filepath.Join("testdata", "terraform_state", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	return parseTerraformState(file)
+}
+
+func TestParseTerraformStateV4(t *testing.T) {
+	state, err := parseTerraformStateFixture(t, "state_v4.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state.Version != 4 || state.TerraformVersion != "1.2.9" {
+		t.Errorf("unexpected state header %d %s", state.Version, state.TerraformVersion)
+	}
+
+	var addresses []string
+	for _, resource := range state.Resources {
+		addresses = append(addresses, resource.Address)
+	}
+	expected := `oci_core_vcn.main,module.app.oci_core_instance.web[0],module.app.oci_core_instance.web[1],oci_objectstorage_bucket.logs["audit"]`
+	if strings.Join(addresses, ",") != expected {
+		t.Fatalf("unexpected addresses %v", addresses)
+	}
+
+	vcn := state.Resources[0]
+	if vcn.Type != "oci_core_vcn" || vcn.Name != "main" || vcn.Module != "" || vcn.IndexKey != nil {
+		t.Errorf("unexpected resource %+v", vcn)
+	}
+	if vcn.Provider != "registry.terraform.io/oracle/oci" || vcn.ProviderAlias != nil {
+		t.Errorf("unexpected provider %s", vcn.Provider)
+	}
+	if vcn.Id == nil || *vcn.Id != "ocid1.vcn.oc1.ap-mumbai-1.aaaa" {
+		t.Errorf("unexpected id %v", vcn.Id)
+	}
+	if vcn.CompartmentId == nil || *vcn.CompartmentId != "ocid1.compartment.oc1..aaaa" {
+		t.Errorf("unexpected compartment id %v", vcn.CompartmentId)
+	}
+
+	instance := state.Resources[2]
+	if instance.Module != "module.app" || instance.IndexKey == nil || *instance.IndexKey != "1" {
+		t.Errorf("unexpected resource %+v", instance)
+	}
+	if instance.ProviderAlias == nil || *instance.ProviderAlias != "home" {
+		t.Errorf("unexpected provider alias %v", instance.ProviderAlias)
+	}
+}
+
+func TestParseTerraformStateV3(t *testing.T) {
+	state, err := parseTerraformStateFixture(t, "state_v3.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(state.Resources) != 2 {
+		t.Fatalf("expected 2 resources, got %d", len(state.Resources))
+	}
+
+	vcn := state.Resources[0]
+	if vcn.Address != "oci_core_vcn.main" || vcn.Provider != "oci" || vcn.Id == nil || *vcn.Id != "ocid1.vcn.oc1.ap-mumbai-1.aaaa" {
+		t.Errorf("unexpected resource %+v", vcn)
+	}
+
+	instance := state.Resources[1]
+	if instance.Address != "module.app.oci_core_instance.web[1]" || instance.ProviderAlias == nil || *instance.ProviderAlias != "home" {
+		t.Errorf("unexpected resource %+v", instance)
+	}
+}
+
+func TestParseTerraformStateEmpty(t *testing.T) {
+	state, err := parseTerraformState(strings.NewReader(""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(state.Resources) != 0 {
+		t.Errorf("expected no resources, got %d", len(state.Resources))
+	}
+}
+
+func TestParseTerraformStateUnsupportedVersion(t *testing.T) {
+	_, err := parseTerraformStateFixture(t, "state_v2.json")
+	if err == nil || !strings.Contains(err.Error(), "unsupported terraform state version 2") {
+		t.Errorf("expected unsupported version error, got %v", err)
+	}
+}
@@ -2,8 +2,11 @@ package oci
 
 import (
 	"context"
+	"encoding/json"
 	"math"
 	"math/rand"
+	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
@@ -116,3 +119,56 @@ func ociRegionName(_ context.Context, d *transform.TransformData) (interface{},
 	regionName := oci_common.StringToRegion(types.SafeString(splittedID[3]))
 	return regionName, nil
 }
+
+// signedResponse adapts a raw http response to the SDK's OCIResponse interface,
+// so that it can be evaluated by a retry policy
+type signedResponse struct {
+	raw *http.Response
+}
+
+func (r signedResponse) HTTPResponse() *http.Response {
+	return r.raw
+}
+
+// callSignedRequest issues a signed GET request through a base client, for
+// services that have no client in the SDK. The request is retried according to
+// the connection's retry policy and the JSON response body is decoded into out.
+func callSignedRequest(ctx context.Context, d *plugin.QueryData, client oci_common.BaseClient, requestPath string, query url.Values, out interface{}) error {
+	_, err := callSignedRequestWithHeaders(ctx, d, client, requestPath, query, out)
+	return err
+}
+
+// callSignedRequestWithHeaders is callSignedRequest for list operations that
+// page through the opc-next-page response header
+func callSignedRequestWithHeaders(ctx context.Context, d *plugin.QueryData, client oci_common.BaseClient, requestPath string, query url.Values, out interface{}) (http.Header, error) {
+	policy := getDefaultRetryPolicy(d.Connection)
+
+	for attempt := uint(1); ; attempt++ {
+		request, err := http.NewRequest(http.MethodGet, requestPath, nil)
+		if err != nil {
+			return nil, err
+		}
+		request.URL.RawQuery = query.Encode()
+		request.Header.Set("Accept", "application/json")
+
+		response, err := client.Call(ctx, request)
+		if err == nil {
+			defer response.Body.Close()
+			return response.Header, json.NewDecoder(response.Body).Decode(out)
+		}
+		if response != nil && response.Body != nil {
+			response.Body.Close()
+		}
+
+		operationResponse := oci_common.NewOCIOperationResponse(signedResponse{response}, err, attempt)
+		if (policy.MaximumNumberAttempts != 0 && attempt >= policy.MaximumNumberAttempts) || !policy.ShouldRetryOperation(operationResponse) {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(policy.NextDuration(operationResponse)):
+		}
+	}
+}